@@ -33,6 +33,11 @@ data "kkp_cluster_kubeconfig_v2" "kube" {
   cluster_id = var.cluster_id_for_kubeconfig
 }
 
+# Per-component health for an existing cluster (reuses cluster_id_for_kubeconfig)
+data "kkp_cluster_health_v2" "health" {
+  cluster_id = var.cluster_id_for_kubeconfig
+}
+
 output "ssh_keys" {
   value = data.kkp_ssh_keys_v2.all.ssh_keys
 }
@@ -49,3 +54,7 @@ output "machine_deployments" {
 output "kubeconfig_preview" {
   value = var.cluster_id_for_kubeconfig == "" ? null : substr(nonsensitive(data.kkp_cluster_kubeconfig_v2.kube.content), 0, 120)
 }
+
+output "cluster_health" {
+  value = var.cluster_id_for_kubeconfig == "" ? null : data.kkp_cluster_health_v2.health.health
+}
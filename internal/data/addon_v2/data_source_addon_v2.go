@@ -29,16 +29,19 @@ func (d *dataSourceAddons) Metadata(_ context.Context, req datasource.MetadataRe
 
 func (d *dataSourceAddons) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dsschema.Schema{
-		Description: "Retrieve information about installed and available addons for a KKP cluster using V2 API.",
+		Description: "Retrieve information about installed and available addons for a KKP cluster using V2 API, optionally filtered by name.",
 		Attributes: map[string]dsschema.Attribute{
 			"id": dsschema.StringAttribute{
 				Computed:    true,
 				Description: "Data source identifier.",
 			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
 			"cluster_id": dsschema.StringAttribute{
 				Required:    true,
 				Description: "Cluster ID to retrieve addons for.",
 			},
+			"name":       kkp.NameFilterAttribute("addons"),
+			"name_regex": kkp.NameRegexFilterAttribute("addons"),
 			"addons": dsschema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of currently installed addons.",
@@ -97,6 +100,10 @@ func (d *dataSourceAddons) Read(ctx context.Context, req datasource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
 	clusterID := kkp.TrimmedStringValue(config.ClusterID)
 	if clusterID == "" {
@@ -104,16 +111,21 @@ func (d *dataSourceAddons) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	aclient := acli.New(d.Client.Transport, nil)
+	filter := kkp.NewFilter(ctx, config.Name, config.NameRegex, types.MapNull(types.StringType), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclient := d.Client.Addons
 
 	// Get installed addons
-	listParams := acli.NewListAddonsV2Params().
-		WithProjectID(d.DefaultProjectID).
+	listParams := acli.NewListAddonsV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID)
 
 	listResp, err := aclient.ListAddonsV2(listParams, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to list installed addons", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list installed addons", err)
 		return
 	}
 
@@ -121,7 +133,7 @@ func (d *dataSourceAddons) Read(ctx context.Context, req datasource.ReadRequest,
 	var installedAddons []addonDataModel
 	if listResp.Payload != nil {
 		for _, addon := range listResp.Payload {
-			if addon != nil {
+			if addon != nil && filter.Match(addon.Name, nil) {
 				addonModel := addonDataModel{
 					ID:   types.StringValue(addon.ID),
 					Name: types.StringValue(addon.Name),
@@ -154,13 +166,13 @@ func (d *dataSourceAddons) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get available addons
-	availableParams := acli.NewListInstallableAddonsV2Params().
-		WithProjectID(d.DefaultProjectID).
+	availableParams := acli.NewListInstallableAddonsV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID)
 
 	availableResp, err := aclient.ListInstallableAddonsV2(availableParams, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to list available addons", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list available addons", err)
 		return
 	}
 
@@ -173,6 +185,9 @@ func (d *dataSourceAddons) Read(ctx context.Context, req datasource.ReadRequest,
 		sort.Strings(addonNames)
 
 		for _, addonName := range addonNames {
+			if !filter.Match(addonName, nil) {
+				continue
+			}
 			availableModel := availableAddonModel{
 				Name:        types.StringValue(addonName),
 				Description: types.StringValue(""), // No description available in V2 API
@@ -193,12 +208,11 @@ func (d *dataSourceAddons) Read(ctx context.Context, req datasource.ReadRequest,
 	})
 
 	// Set the data
-	state := addonsDataSourceModel{
-		ID:        types.StringValue("addons-" + clusterID),
-		ClusterID: config.ClusterID,
-		Addons:    installedAddons,
-		Available: availableAddons,
-	}
+	state := config
+	state.ID = types.StringValue("addons-" + clusterID)
+	state.ProjectID = types.StringValue(projectID)
+	state.Addons = installedAddons
+	state.Available = availableAddons
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -16,7 +16,10 @@ type dataSourceAddons struct {
 
 type addonsDataSourceModel struct {
 	ID        types.String          `tfsdk:"id"`
+	ProjectID types.String          `tfsdk:"project_id"`
 	ClusterID types.String          `tfsdk:"cluster_id"`
+	Name      types.String          `tfsdk:"name"`       // optional filter: exact addon name
+	NameRegex types.String          `tfsdk:"name_regex"` // optional filter: regular expression on the name
 	Addons    []addonDataModel      `tfsdk:"addons"`
 	Available []availableAddonModel `tfsdk:"available"`
 }
@@ -29,19 +29,23 @@ func (d *dataSourceApplications) Metadata(_ context.Context, req datasource.Meta
 
 func (d *dataSourceApplications) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dschema.Schema{
-		Description: "Retrieves a list of application installations from KKP using V2 API. Requires cluster_id to list applications for a specific cluster.",
+		Description: "Retrieves a list of application installations from KKP using V2 API. Requires cluster_id to list applications for a specific cluster; results can be filtered by name and labels.",
 		Attributes: map[string]dschema.Attribute{
 			"id": dschema.StringAttribute{
 				Computed:    true,
 				Description: "Data source identifier.",
 			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
 			"cluster_id": dschema.StringAttribute{
 				Required:    true,
 				Description: "Cluster ID to list applications from.",
 			},
+			"name":       kkp.NameFilterAttribute("application installations"),
+			"name_regex": kkp.NameRegexFilterAttribute("application installations"),
+			"labels":     kkp.LabelsFilterAttribute("application installations"),
 			"applications": dschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "List of application installations in the specified cluster.",
+				Description: "Matching application installations in the specified cluster, sorted by name.",
 				NestedObject: dschema.NestedAttributeObject{
 					Attributes: map[string]dschema.Attribute{
 						"id": dschema.StringAttribute{
@@ -101,6 +105,10 @@ func (d *dataSourceApplications) Read(ctx context.Context, req datasource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
 	clusterID := kkp.TrimmedStringValue(config.ClusterID)
 	if clusterID == "" {
@@ -108,14 +116,19 @@ func (d *dataSourceApplications) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	aclient := acli.New(d.Client.Transport, nil)
-	params := acli.NewListApplicationInstallationsParams().
-		WithProjectID(d.DefaultProjectID).
+	filter := kkp.NewFilter(ctx, config.Name, config.NameRegex, config.Labels, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclient := d.Client.Applications
+	params := acli.NewListApplicationInstallationsParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID)
 
 	applicationsResp, err := aclient.ListApplicationInstallations(params, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to list applications", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list applications", err)
 		return
 	}
 
@@ -126,7 +139,7 @@ func (d *dataSourceApplications) Read(ctx context.Context, req datasource.ReadRe
 
 	applications := make([]applicationSummary, 0, len(applicationsResp.Payload))
 	for _, app := range applicationsResp.Payload {
-		if app == nil {
+		if app == nil || !filter.Match(app.Name, app.Labels) {
 			continue
 		}
 
@@ -177,14 +190,14 @@ func (d *dataSourceApplications) Read(ctx context.Context, req datasource.ReadRe
 		return applications[i].Name.ValueString() < applications[j].Name.ValueString()
 	})
 
-	state := applicationsDataSourceModel{
-		ID:           types.StringValue("applications-" + clusterID),
-		ClusterID:    types.StringValue(clusterID),
-		Applications: applications,
-	}
+	state := config
+	state.ID = types.StringValue("applications-" + clusterID)
+	state.ProjectID = types.StringValue(projectID)
+	state.ClusterID = types.StringValue(clusterID)
+	state.Applications = applications
 
 	tflog.Info(ctx, "successfully listed applications", map[string]any{
-		"project_id":        d.DefaultProjectID,
+		"project_id":        projectID,
 		"cluster_id":        clusterID,
 		"application_count": len(applications),
 	})
@@ -16,7 +16,11 @@ type dataSourceApplications struct {
 
 type applicationsDataSourceModel struct {
 	ID           types.String         `tfsdk:"id"`
+	ProjectID    types.String         `tfsdk:"project_id"`
 	ClusterID    types.String         `tfsdk:"cluster_id"`
+	Name         types.String         `tfsdk:"name"`       // optional filter: exact installation name
+	NameRegex    types.String         `tfsdk:"name_regex"` // optional filter: regular expression on the name
+	Labels       types.Map            `tfsdk:"labels"`     // optional filter: all labels must match
 	Applications []applicationSummary `tfsdk:"applications"`
 }
 
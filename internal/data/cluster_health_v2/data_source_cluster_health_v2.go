@@ -0,0 +1,116 @@
+// Package cluster_health_v2 implements a data source to read per-component cluster health.
+package cluster_health_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var _ datasource.DataSource = &dataSourceClusterHealth{}
+var _ datasource.DataSourceWithConfigure = &dataSourceClusterHealth{}
+
+// NewDataSource creates a new cluster health v2 data source.
+func NewDataSource() datasource.DataSource { return &dataSourceClusterHealth{} }
+
+func (d *dataSourceClusterHealth) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_health_v2"
+}
+
+func (d *dataSourceClusterHealth) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	healthAttrs := map[string]dschema.Attribute{}
+	for name, description := range kkp.HealthAttributeDescriptions() {
+		healthAttrs[name] = dschema.StringAttribute{
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	resp.Schema = dschema.Schema{
+		Description: "Fetch the per-component health of a user cluster via KKP API V2.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Data source identifier.",
+			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"cluster_id": dschema.StringAttribute{
+				Required:    true,
+				Description: "Cluster ID to fetch health for.",
+			},
+			"healthy": dschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether all core control-plane and worker-critical components are up.",
+			},
+			"health": dschema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Per-component health reported by KKP.",
+				Attributes:  healthAttrs,
+			},
+		},
+	}
+}
+
+func (d *dataSourceClusterHealth) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.ConfigureDataSource(req, resp)
+}
+
+func (d *dataSourceClusterHealth) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.ValidateDataSourceBase(resp) {
+		return
+	}
+
+	var config clusterHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	clusterID := kkp.TrimmedStringValue(config.ClusterID)
+	if clusterID == "" {
+		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id is required to fetch cluster health")
+		return
+	}
+
+	checker := &kkp.ClusterHealthChecker{
+		Client:    d.Client,
+		ProjectID: projectID,
+		ClusterID: clusterID,
+	}
+	health, err := checker.FetchHealth(ctx)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to fetch cluster health", err)
+		return
+	}
+
+	healthValue, diags := kkp.HealthObjectValue(health)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := clusterHealthDataSourceModel{
+		ID:        types.StringValue("health-" + clusterID),
+		ProjectID: types.StringValue(projectID),
+		ClusterID: types.StringValue(clusterID),
+		Healthy:   types.BoolValue(kkp.HealthReady(health)),
+		Health:    healthValue,
+	}
+
+	tflog.Info(ctx, "fetched cluster health", map[string]any{
+		"project_id": projectID,
+		"cluster_id": clusterID,
+		"healthy":    state.Healthy.ValueBool(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,21 @@
+package cluster_health_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// Data source implementation struct
+type dataSourceClusterHealth struct {
+	kkp.DataSourceBase
+}
+
+// Terraform state/config model
+type clusterHealthDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Healthy   types.Bool   `tfsdk:"healthy"`
+	Health    types.Object `tfsdk:"health"`
+}
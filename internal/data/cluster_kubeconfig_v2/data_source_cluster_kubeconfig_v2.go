@@ -33,6 +33,7 @@ func (d *dataSourceClusterKubeconfig) Schema(_ context.Context, _ datasource.Sch
 				Computed:    true,
 				Description: "Data source identifier.",
 			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
 			"cluster_id": dschema.StringAttribute{
 				Required:    true,
 				Description: "Cluster ID to fetch kubeconfig for.",
@@ -65,6 +66,10 @@ func (d *dataSourceClusterKubeconfig) Read(ctx context.Context, req datasource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
 	clusterID := kkp.TrimmedStringValue(config.ClusterID)
 	if clusterID == "" {
@@ -73,14 +78,14 @@ func (d *dataSourceClusterKubeconfig) Read(ctx context.Context, req datasource.R
 	}
 
 	// Call API
-	pcli := kapi.New(d.Client.Transport, nil)
+	pcli := d.Client.Project
 	var payload []byte
-	params := kapi.NewGetClusterKubeconfigV2Params().
-		WithProjectID(d.DefaultProjectID).
+	params := kapi.NewGetClusterKubeconfigV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID)
 	out, err := pcli.GetClusterKubeconfigV2(params, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to fetch kubeconfig", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to fetch kubeconfig", err)
 		return
 	}
 	payload = out.GetPayload()
@@ -89,13 +94,14 @@ func (d *dataSourceClusterKubeconfig) Read(ctx context.Context, req datasource.R
 	content := string(payload)
 	state := kubeconfigDataSourceModel{
 		ID:            types.StringValue("kubeconfig-" + clusterID),
+		ProjectID:     types.StringValue(projectID),
 		ClusterID:     types.StringValue(clusterID),
 		Content:       types.StringValue(content),
 		ContentBase64: types.StringValue(base64.StdEncoding.EncodeToString(payload)),
 	}
 
 	tflog.Info(ctx, "fetched cluster kubeconfig", map[string]any{
-		"project_id": d.DefaultProjectID,
+		"project_id": projectID,
 		"cluster_id": clusterID,
 	})
 
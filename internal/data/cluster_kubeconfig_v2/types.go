@@ -14,6 +14,7 @@ type dataSourceClusterKubeconfig struct {
 // Terraform state/config model
 type kubeconfigDataSourceModel struct {
 	ID            types.String `tfsdk:"id"`
+	ProjectID     types.String `tfsdk:"project_id"`
 	ClusterID     types.String `tfsdk:"cluster_id"`
 	Content       types.String `tfsdk:"content"`
 	ContentBase64 types.String `tfsdk:"content_base64"`
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	kapi "github.com/kubermatic/go-kubermatic/client/project"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
 )
 
 var _ datasource.DataSource = &dataSourceClusterTemplates{}
@@ -30,6 +32,7 @@ func (d *dataSourceClusterTemplates) Schema(_ context.Context, _ datasource.Sche
 				Computed:    true,
 				Description: "Data source identifier.",
 			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
 			"templates": dschema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of cluster templates in the project.",
@@ -63,19 +66,23 @@ func (d *dataSourceClusterTemplates) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	var _cfg clusterTemplatesDataSourceModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &_cfg)...) // no inputs yet; keeps symmetry
+	var config clusterTemplatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
-	pcli := kapi.New(d.Client.Transport, nil)
+	pcli := d.Client.Project
 	out, err := pcli.ListClusterTemplates(
-		kapi.NewListClusterTemplatesParams().WithProjectID(d.DefaultProjectID),
+		kapi.NewListClusterTemplatesParams().WithContext(ctx).WithProjectID(projectID),
 		nil,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to list cluster templates", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list cluster templates", err)
 		return
 	}
 
@@ -99,12 +106,13 @@ func (d *dataSourceClusterTemplates) Read(ctx context.Context, req datasource.Re
 	}
 
 	state := clusterTemplatesDataSourceModel{
-		ID:        types.StringValue("cluster-templates-" + d.DefaultProjectID),
+		ID:        types.StringValue("cluster-templates-" + projectID),
+		ProjectID: types.StringValue(projectID),
 		Templates: items,
 	}
 
 	tflog.Info(ctx, "listed cluster templates", map[string]any{
-		"project_id":     d.DefaultProjectID,
+		"project_id":     projectID,
 		"template_count": len(items),
 	})
 
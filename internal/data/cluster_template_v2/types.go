@@ -14,6 +14,7 @@ type dataSourceClusterTemplates struct {
 // Data model for the data source state
 type clusterTemplatesDataSourceModel struct {
 	ID        types.String        `tfsdk:"id"`
+	ProjectID types.String        `tfsdk:"project_id"`
 	Templates []clusterTemplateEl `tfsdk:"templates"`
 }
 
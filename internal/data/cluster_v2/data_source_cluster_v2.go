@@ -4,9 +4,12 @@ package cluster_v2
 import (
 	"context"
 	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -29,17 +32,30 @@ func (d *dataSourceClusters) Metadata(_ context.Context, req datasource.Metadata
 
 func (d *dataSourceClusters) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dschema.Schema{
-		Description: "Retrieves a list of clusters from KKP using V2 API.",
+		Description: "Retrieves a list of clusters from KKP using V2 API, optionally filtered by name, labels, cloud and datacenter.",
 		Attributes: map[string]dschema.Attribute{
 			"id": dschema.StringAttribute{
 				Computed:    true,
 				Description: "Data source identifier.",
 			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"name":       kkp.NameFilterAttribute("clusters"),
+			"name_regex": kkp.NameRegexFilterAttribute("clusters"),
+			"labels":     kkp.LabelsFilterAttribute("clusters"),
+			"cloud": dschema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters on this cloud provider (aws, azure, gcp, openstack or vsphere).",
+			},
+			"datacenter": dschema.StringAttribute{
+				Optional:    true,
+				Description: "Only return clusters in this datacenter.",
+			},
+			"include_health": includeHealthAttribute(),
 			"clusters": dschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "List of clusters available in the project.",
+				Description: "Matching clusters in the project, sorted by name.",
 				NestedObject: dschema.NestedAttributeObject{
-					Attributes: map[string]dschema.Attribute{
+					Attributes: withClusterFacts(map[string]dschema.Attribute{
 						"id": dschema.StringAttribute{
 							Computed:    true,
 							Description: "Cluster ID.",
@@ -73,7 +89,7 @@ func (d *dataSourceClusters) Schema(_ context.Context, _ datasource.SchemaReques
 							Computed:    true,
 							Description: "Cluster labels as key-value pairs.",
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -94,10 +110,14 @@ func (d *dataSourceClusters) Read(ctx context.Context, req datasource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
-	clustersPayload, err := d.FetchClusters()
+	clustersPayload, err := d.FetchClusters(ctx, projectID)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to list clusters", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list clusters", err)
 		return
 	}
 
@@ -106,15 +126,25 @@ func (d *dataSourceClusters) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	clusters := convertClustersToSummaries(clustersPayload)
+	filter := newClusterFilter(ctx, config.Name, config.NameRegex, config.Labels, config.Cloud, config.Datacenter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	state := clustersDataSourceModel{
-		ID:       types.StringValue("clusters-" + d.DefaultProjectID),
-		Clusters: clusters,
+	clusters := convertClustersToSummaries(filter.apply(clustersPayload))
+	if config.IncludeHealth.ValueBool() {
+		for i := range clusters {
+			setClusterHealth(ctx, d.Client, projectID, clusters[i].ID.ValueString(), &clusters[i].clusterFacts, &resp.Diagnostics)
+		}
 	}
 
+	state := config
+	state.ID = types.StringValue("clusters-" + projectID)
+	state.ProjectID = types.StringValue(projectID)
+	state.Clusters = clusters
+
 	tflog.Info(ctx, "successfully listed clusters", map[string]any{
-		"project_id":    d.DefaultProjectID,
+		"project_id":    projectID,
 		"cluster_count": len(clusters),
 	})
 
@@ -141,8 +171,9 @@ func convertClustersToSummaries(clusters []*models.Cluster) []clusterSummary {
 
 func convertClusterToSummary(cluster *models.Cluster) clusterSummary {
 	summary := clusterSummary{
-		ID:   types.StringValue(cluster.ID),
-		Name: types.StringValue(cluster.Name),
+		ID:           types.StringValue(cluster.ID),
+		Name:         types.StringValue(cluster.Name),
+		clusterFacts: convertClusterFacts(cluster),
 	}
 
 	// Set creation time if available
@@ -156,24 +187,13 @@ func convertClusterToSummary(cluster *models.Cluster) clusterSummary {
 	}
 
 	// Set cloud provider if available
-	if cluster.Spec != nil && cluster.Spec.Cloud != nil {
-		switch {
-		case cluster.Spec.Cloud.Aws != nil:
-			summary.Cloud = types.StringValue("aws")
-		case cluster.Spec.Cloud.Azure != nil:
-			summary.Cloud = types.StringValue("azure")
-		case cluster.Spec.Cloud.Gcp != nil:
-			summary.Cloud = types.StringValue("gcp")
-		case cluster.Spec.Cloud.Openstack != nil:
-			summary.Cloud = types.StringValue("openstack")
-		case cluster.Spec.Cloud.Vsphere != nil:
-			summary.Cloud = types.StringValue("vsphere")
-		}
+	if cloud := clusterCloud(cluster); cloud != "" {
+		summary.Cloud = types.StringValue(cloud)
 	}
 
 	// Set datacenter name if available
-	if cluster.Spec != nil && cluster.Spec.Cloud != nil && cluster.Spec.Cloud.DatacenterName != "" {
-		summary.DatacenterName = types.StringValue(cluster.Spec.Cloud.DatacenterName)
+	if dc := clusterDatacenter(cluster); dc != "" {
+		summary.DatacenterName = types.StringValue(dc)
 	}
 
 	// Set Kubernetes version if available
@@ -186,3 +206,182 @@ func convertClusterToSummary(cluster *models.Cluster) clusterSummary {
 
 	return summary
 }
+
+// ---------- Cluster facts ----------
+
+// withClusterFacts adds the spec and status attributes shared by the list and singular data
+// sources to attrs.
+func withClusterFacts(attrs map[string]dschema.Attribute) map[string]dschema.Attribute {
+	attrs["cni_type"] = dschema.StringAttribute{
+		Computed:    true,
+		Description: "CNI plugin type (e.g., cilium, canal).",
+	}
+	attrs["cni_version"] = dschema.StringAttribute{
+		Computed:    true,
+		Description: "CNI plugin version.",
+	}
+	attrs["api_server_url"] = dschema.StringAttribute{
+		Computed:    true,
+		Description: "URL of the cluster's Kubernetes API server.",
+	}
+	attrs["expose_strategy"] = dschema.StringAttribute{
+		Computed:    true,
+		Description: "How the control plane is exposed (NodePort, LoadBalancer or Tunneling).",
+	}
+	attrs["pods_cidr_blocks"] = dschema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "Pod network CIDR blocks.",
+	}
+	attrs["services_cidr_blocks"] = dschema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "Service network CIDR blocks.",
+	}
+	attrs["preset"] = dschema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the preset the cluster's cloud credentials come from, if any.",
+	}
+	attrs["machine_deployment_count"] = dschema.Int64Attribute{
+		Computed:    true,
+		Description: "Number of machine deployments in the cluster.",
+	}
+	attrs["deletion_time"] = dschema.StringAttribute{
+		Computed:    true,
+		Description: "Deletion timestamp (RFC3339) if the cluster is being deleted.",
+	}
+	attrs["healthy"] = dschema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the cluster's core components are healthy. Only set when include_health is true; see kkp_cluster_health_v2 for per-component health.",
+	}
+	return attrs
+}
+
+// includeHealthAttribute is the opt-in for the per-cluster health request.
+func includeHealthAttribute() dschema.BoolAttribute {
+	return dschema.BoolAttribute{
+		Optional:    true,
+		Description: "Fetch cluster health to fill in healthy. Costs one extra API request per cluster.",
+	}
+}
+
+func convertClusterFacts(cluster *models.Cluster) clusterFacts {
+	facts := clusterFacts{
+		Preset:                 types.StringValue(cluster.Credential),
+		MachineDeploymentCount: types.Int64Value(cluster.MachineDeploymentCount),
+		PodsCIDRBlocks:         stringList(nil),
+		ServicesCIDRBlocks:     stringList(nil),
+	}
+
+	if !cluster.DeletionTimestamp.IsZero() {
+		facts.DeletionTime = types.StringValue(cluster.DeletionTimestamp.String())
+	}
+	if cluster.Status != nil && cluster.Status.URL != "" {
+		facts.APIServerURL = types.StringValue(cluster.Status.URL)
+	}
+
+	if spec := cluster.Spec; spec != nil {
+		if spec.CniPlugin != nil {
+			facts.CNIType = types.StringValue(string(spec.CniPlugin.Type))
+			facts.CNIVersion = types.StringValue(spec.CniPlugin.Version)
+		}
+		if spec.ExposeStrategy != "" {
+			facts.ExposeStrategy = types.StringValue(string(spec.ExposeStrategy))
+		}
+		if network := spec.ClusterNetwork; network != nil {
+			if network.Pods != nil {
+				facts.PodsCIDRBlocks = stringList(network.Pods.CIDRBlocks)
+			}
+			if network.Services != nil {
+				facts.ServicesCIDRBlocks = stringList(network.Services.CIDRBlocks)
+			}
+		}
+	}
+
+	return facts
+}
+
+// setClusterHealth fills in healthy from the cluster health endpoint. Failures are only warnings,
+// as clusters that are still being provisioned or deleted may not report health.
+func setClusterHealth(ctx context.Context, client *kkp.Client, projectID, clusterID string, facts *clusterFacts, diags *diag.Diagnostics) {
+	health, err := client.GetClusterHealth(ctx, projectID, clusterID)
+	if err != nil {
+		kkp.AddAPIWarning(diags, "Failed to fetch health of cluster "+clusterID, err)
+		return
+	}
+	facts.Healthy = types.BoolValue(kkp.HealthReady(health))
+}
+
+func stringList(values []string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+// ---------- Filtering ----------
+
+// clusterFilter extends the shared name and label filter with the cluster's cloud and datacenter.
+type clusterFilter struct {
+	kkp.Filter
+	cloud      string
+	datacenter string
+}
+
+func newClusterFilter(ctx context.Context, name, nameRegex types.String, labels types.Map, cloud, datacenter types.String, diags *diag.Diagnostics) clusterFilter {
+	return clusterFilter{
+		Filter:     kkp.NewFilter(ctx, name, nameRegex, labels, diags),
+		cloud:      strings.ToLower(kkp.TrimmedStringValue(cloud)),
+		datacenter: kkp.TrimmedStringValue(datacenter),
+	}
+}
+
+func (f clusterFilter) match(cluster *models.Cluster) bool {
+	if !f.Match(cluster.Name, cluster.Labels) {
+		return false
+	}
+	if f.cloud != "" && clusterCloud(cluster) != f.cloud {
+		return false
+	}
+	return f.datacenter == "" || clusterDatacenter(cluster) == f.datacenter
+}
+
+// apply returns the non-nil clusters passing the filter.
+func (f clusterFilter) apply(clusters []*models.Cluster) []*models.Cluster {
+	matched := make([]*models.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		if cluster != nil && f.match(cluster) {
+			matched = append(matched, cluster)
+		}
+	}
+	return matched
+}
+
+// clusterCloud returns the cloud provider name of a cluster, or "" if unknown.
+func clusterCloud(cluster *models.Cluster) string {
+	if cluster.Spec == nil || cluster.Spec.Cloud == nil {
+		return ""
+	}
+	switch {
+	case cluster.Spec.Cloud.Aws != nil:
+		return "aws"
+	case cluster.Spec.Cloud.Azure != nil:
+		return "azure"
+	case cluster.Spec.Cloud.Gcp != nil:
+		return "gcp"
+	case cluster.Spec.Cloud.Openstack != nil:
+		return "openstack"
+	case cluster.Spec.Cloud.Vsphere != nil:
+		return "vsphere"
+	}
+	return ""
+}
+
+// clusterDatacenter returns the datacenter name of a cluster, or "" if unknown.
+func clusterDatacenter(cluster *models.Cluster) string {
+	if cluster.Spec == nil || cluster.Spec.Cloud == nil {
+		return ""
+	}
+	return cluster.Spec.Cloud.DatacenterName
+}
@@ -0,0 +1,150 @@
+package cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/kubermatic/go-kubermatic/models"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var _ datasource.DataSource = &dataSourceCluster{}
+var _ datasource.DataSourceWithConfigure = &dataSourceCluster{}
+
+// NewSingularDataSource creates a new data source looking up a single cluster.
+func NewSingularDataSource() datasource.DataSource {
+	return &dataSourceCluster{}
+}
+
+func (d *dataSourceCluster) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_v2"
+}
+
+func (d *dataSourceCluster) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description: "Looks up a single KKP cluster by ID, name, labels, cloud or datacenter. Fails unless exactly one cluster matches.",
+		Attributes: withClusterFacts(map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Cluster ID to look up.",
+			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"name": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Cluster name to look up.",
+			},
+			"name_regex": kkp.NameRegexFilterAttribute("clusters"),
+			"labels": dschema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Labels the cluster must carry. When not set, all labels of the matched cluster.",
+			},
+			"cloud": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Cloud provider of the cluster (aws, azure, gcp, openstack or vsphere).",
+			},
+			"datacenter": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Datacenter of the cluster.",
+			},
+			"creation_time": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Cluster creation timestamp (RFC3339).",
+			},
+			"type": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Cluster type (e.g., kubernetes).",
+			},
+			"version": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Kubernetes version.",
+			},
+			"include_health": includeHealthAttribute(),
+		}),
+	}
+}
+
+func (d *dataSourceCluster) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.ConfigureDataSource(req, resp)
+}
+
+func (d *dataSourceCluster) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.ValidateDataSourceBase(resp) {
+		return
+	}
+
+	var config clusterDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	filter := newClusterFilter(ctx, config.Name, config.NameRegex, config.Labels, config.Cloud, config.Datacenter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters, err := d.FetchClusters(ctx, projectID)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list clusters", err)
+		return
+	}
+
+	id := kkp.TrimmedStringValue(config.ID)
+	var match *models.Cluster
+	names := make([]string, 0, 1)
+	for _, cluster := range filter.apply(clusters) {
+		if id != "" && cluster.ID != id {
+			continue
+		}
+		match = cluster
+		names = append(names, cluster.Name)
+	}
+	if !kkp.CheckSingleMatch(&resp.Diagnostics, "cluster", names) {
+		return
+	}
+	summary := convertClusterToSummary(match)
+
+	state := config
+	state.ID = summary.ID
+	state.ProjectID = types.StringValue(projectID)
+	state.Name = summary.Name
+	state.CreationTime = summary.CreationTime
+	state.Type = summary.Type
+	state.Version = summary.Version
+	state.clusterFacts = summary.clusterFacts
+	if config.IncludeHealth.ValueBool() {
+		setClusterHealth(ctx, d.Client, projectID, summary.ID.ValueString(), &state.clusterFacts, &resp.Diagnostics)
+	}
+	// Filters given in the configuration are kept as written.
+	if config.Labels.IsNull() {
+		state.Labels = summary.Labels
+	}
+	if config.Cloud.IsNull() {
+		state.Cloud = summary.Cloud
+	}
+	if config.Datacenter.IsNull() {
+		state.Datacenter = summary.DatacenterName
+	}
+
+	tflog.Info(ctx, "found cluster", map[string]any{
+		"project_id": projectID,
+		"cluster_id": summary.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -15,8 +15,15 @@ type dataSourceClusters struct {
 // ---------- Local state structs to read config/state ----------
 
 type clustersDataSourceModel struct {
-	ID       types.String     `tfsdk:"id"`
-	Clusters []clusterSummary `tfsdk:"clusters"`
+	ID            types.String     `tfsdk:"id"`
+	ProjectID     types.String     `tfsdk:"project_id"`
+	Name          types.String     `tfsdk:"name"`           // optional filter: exact cluster name
+	NameRegex     types.String     `tfsdk:"name_regex"`     // optional filter: regular expression on the name
+	Labels        types.Map        `tfsdk:"labels"`         // optional filter: all labels must match
+	Cloud         types.String     `tfsdk:"cloud"`          // optional filter: cloud provider
+	Datacenter    types.String     `tfsdk:"datacenter"`     // optional filter: datacenter name
+	IncludeHealth types.Bool       `tfsdk:"include_health"` // optional: fetch health of every returned cluster
+	Clusters      []clusterSummary `tfsdk:"clusters"`
 }
 
 type clusterSummary struct {
@@ -28,4 +35,40 @@ type clusterSummary struct {
 	DatacenterName types.String `tfsdk:"datacenter_name"`
 	Version        types.String `tfsdk:"version"`
 	Labels         types.Map    `tfsdk:"labels"`
+	clusterFacts
+}
+
+// clusterFacts holds the spec and status details shared by the list and singular data sources.
+type clusterFacts struct {
+	CNIType                types.String `tfsdk:"cni_type"`
+	CNIVersion             types.String `tfsdk:"cni_version"`
+	APIServerURL           types.String `tfsdk:"api_server_url"`
+	ExposeStrategy         types.String `tfsdk:"expose_strategy"`
+	PodsCIDRBlocks         types.List   `tfsdk:"pods_cidr_blocks"`
+	ServicesCIDRBlocks     types.List   `tfsdk:"services_cidr_blocks"`
+	Preset                 types.String `tfsdk:"preset"`
+	MachineDeploymentCount types.Int64  `tfsdk:"machine_deployment_count"`
+	DeletionTime           types.String `tfsdk:"deletion_time"`
+	Healthy                types.Bool   `tfsdk:"healthy"` // only set with include_health
+}
+
+// ---------- Singular data source ----------
+
+type dataSourceCluster struct {
+	kkp.DataSourceBase
+}
+
+type clusterDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`         // optional lookup, computed otherwise
+	ProjectID     types.String `tfsdk:"project_id"` // optional, defaults to the provider project
+	Name          types.String `tfsdk:"name"`       // optional lookup, computed otherwise
+	NameRegex     types.String `tfsdk:"name_regex"` // optional filter
+	Labels        types.Map    `tfsdk:"labels"`     // optional filter, computed otherwise
+	Cloud         types.String `tfsdk:"cloud"`      // optional filter, computed otherwise
+	Datacenter    types.String `tfsdk:"datacenter"` // optional filter, computed otherwise
+	CreationTime  types.String `tfsdk:"creation_time"`
+	Type          types.String `tfsdk:"type"`
+	Version       types.String `tfsdk:"version"`
+	IncludeHealth types.Bool   `tfsdk:"include_health"` // optional: fetch the cluster's health
+	clusterFacts
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	kapi "github.com/kubermatic/go-kubermatic/client/project"
 	"github.com/kubermatic/go-kubermatic/models"
 
 	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
@@ -31,19 +30,23 @@ func (d *dataSourceMachineDeployments) Metadata(_ context.Context, req datasourc
 
 func (d *dataSourceMachineDeployments) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dschema.Schema{
-		Description: "Retrieves a list of machine deployments from KKP using V2 API. Requires cluster_id to list machine deployments for a specific cluster.",
+		Description: "Retrieves a list of machine deployments from KKP using V2 API. Requires cluster_id to list machine deployments for a specific cluster; results can be filtered by name and labels.",
 		Attributes: map[string]dschema.Attribute{
 			"id": dschema.StringAttribute{
 				Computed:    true,
 				Description: "Data source identifier.",
 			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
 			"cluster_id": dschema.StringAttribute{
 				Required:    true,
 				Description: "Cluster ID to list machine deployments from.",
 			},
+			"name":       kkp.NameFilterAttribute("machine deployments"),
+			"name_regex": kkp.NameRegexFilterAttribute("machine deployments"),
+			"labels":     kkp.LabelsFilterAttribute("machine deployments"),
 			"machine_deployments": dschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "List of machine deployments in the specified cluster.",
+				Description: "Matching machine deployments in the specified cluster, sorted by name.",
 				NestedObject: dschema.NestedAttributeObject{
 					Attributes: map[string]dschema.Attribute{
 						"id": dschema.StringAttribute{
@@ -96,28 +99,37 @@ func (d *dataSourceMachineDeployments) Read(ctx context.Context, req datasource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
 
 	clusterID := d.validateClusterID(&config, resp)
 	if clusterID == "" {
 		return
 	}
 
-	machineDeployments, err := d.fetchMachineDeployments(clusterID)
+	filter := kkp.NewFilter(ctx, config.Name, config.NameRegex, config.Labels, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineDeployments, err := fetchMachineDeployments(ctx, d.Client, projectID, clusterID)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to list machine deployments", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list machine deployments", err)
 		return
 	}
 
-	summaries := d.convertMachineDeploymentsToSummaries(machineDeployments, clusterID)
+	summaries := convertMachineDeploymentsToSummaries(filterMachineDeployments(machineDeployments, filter), clusterID)
 
-	state := machineDeploymentsDataSourceModel{
-		ID:                 types.StringValue("machine-deployments-" + clusterID),
-		ClusterID:          types.StringValue(clusterID),
-		MachineDeployments: summaries,
-	}
+	state := config
+	state.ID = types.StringValue("machine-deployments-" + clusterID)
+	state.ProjectID = types.StringValue(projectID)
+	state.ClusterID = types.StringValue(clusterID)
+	state.MachineDeployments = summaries
 
 	tflog.Info(ctx, "successfully listed machine deployments", map[string]any{
-		"project_id":               d.DefaultProjectID,
+		"project_id":               projectID,
 		"cluster_id":               clusterID,
 		"machine_deployment_count": len(summaries),
 	})
@@ -136,33 +148,39 @@ func (d *dataSourceMachineDeployments) validateClusterID(config *machineDeployme
 }
 
 // fetchMachineDeployments retrieves machine deployments from the API for the given cluster.
-func (d *dataSourceMachineDeployments) fetchMachineDeployments(clusterID string) ([]*models.NodeDeployment, error) {
-	pcli := kapi.New(d.Client.Transport, nil)
-	params := kapi.NewListMachineDeploymentsParams().
-		WithProjectID(d.DefaultProjectID).
-		WithClusterID(clusterID)
-
-	machineDeploymentsResp, err := pcli.ListMachineDeployments(params, nil)
+func fetchMachineDeployments(ctx context.Context, client *kkp.Client, projectID, clusterID string) ([]*models.NodeDeployment, error) {
+	machineDeployments, err := client.ListMachineDeployments(ctx, projectID, clusterID)
 	if err != nil {
 		return nil, err
 	}
 
-	if machineDeploymentsResp.Payload == nil {
+	if machineDeployments == nil {
 		return nil, errors.New("machine deployments list response was empty")
 	}
 
-	return machineDeploymentsResp.Payload, nil
+	return machineDeployments, nil
+}
+
+// filterMachineDeployments returns the non-nil machine deployments passing the filter.
+func filterMachineDeployments(machineDeployments []*models.NodeDeployment, filter kkp.Filter) []*models.NodeDeployment {
+	matched := make([]*models.NodeDeployment, 0, len(machineDeployments))
+	for _, md := range machineDeployments {
+		if md != nil && filter.Match(md.Name, machineDeploymentLabels(md)) {
+			matched = append(matched, md)
+		}
+	}
+	return matched
 }
 
 // convertMachineDeploymentsToSummaries converts API model objects to summary objects.
-func (d *dataSourceMachineDeployments) convertMachineDeploymentsToSummaries(machineDeployments []*models.NodeDeployment, clusterID string) []machineDeploymentSummary {
+func convertMachineDeploymentsToSummaries(machineDeployments []*models.NodeDeployment, clusterID string) []machineDeploymentSummary {
 	summaries := make([]machineDeploymentSummary, 0, len(machineDeployments))
 
 	for _, md := range machineDeployments {
 		if md == nil {
 			continue
 		}
-		summaries = append(summaries, d.convertMachineDeploymentToSummary(md, clusterID))
+		summaries = append(summaries, convertMachineDeploymentToSummary(md, clusterID))
 	}
 
 	// Sort machine deployments alphabetically by name for consistent ordering
@@ -174,7 +192,7 @@ func (d *dataSourceMachineDeployments) convertMachineDeploymentsToSummaries(mach
 }
 
 // convertMachineDeploymentToSummary converts a single machine deployment to a summary object.
-func (d *dataSourceMachineDeployments) convertMachineDeploymentToSummary(md *models.NodeDeployment, clusterID string) machineDeploymentSummary {
+func convertMachineDeploymentToSummary(md *models.NodeDeployment, clusterID string) machineDeploymentSummary {
 	summary := machineDeploymentSummary{
 		ID:        types.StringValue(md.ID),
 		Name:      types.StringValue(md.Name),
@@ -196,12 +214,15 @@ func (d *dataSourceMachineDeployments) convertMachineDeploymentToSummary(md *mod
 		summary.ReadyReplicas = types.Int64Value(int64(md.Status.ReadyReplicas))
 	}
 
-	// Convert machine deployment labels - check both Spec and root level
-	var labels map[string]string
-	if md.Spec != nil && md.Spec.Template != nil && md.Spec.Template.Labels != nil {
-		labels = md.Spec.Template.Labels
-	}
-	summary.Labels = kkp.ConvertLabelsToTerraform(labels)
+	summary.Labels = kkp.ConvertLabelsToTerraform(machineDeploymentLabels(md))
 
 	return summary
 }
+
+// machineDeploymentLabels returns the node labels of a machine deployment's template.
+func machineDeploymentLabels(md *models.NodeDeployment) map[string]string {
+	if md.Spec != nil && md.Spec.Template != nil {
+		return md.Spec.Template.Labels
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package machine_deployment_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/kubermatic/go-kubermatic/models"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var _ datasource.DataSource = &dataSourceMachineDeployment{}
+var _ datasource.DataSourceWithConfigure = &dataSourceMachineDeployment{}
+
+// NewSingularDataSource creates a new data source looking up a single machine deployment.
+func NewSingularDataSource() datasource.DataSource {
+	return &dataSourceMachineDeployment{}
+}
+
+func (d *dataSourceMachineDeployment) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_deployment_v2"
+}
+
+func (d *dataSourceMachineDeployment) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description: "Looks up a single machine deployment of a KKP cluster by ID, name or labels. Fails unless exactly one machine deployment matches.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Machine deployment ID to look up.",
+			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"cluster_id": dschema.StringAttribute{
+				Required:    true,
+				Description: "Cluster ID to look up the machine deployment in.",
+			},
+			"name": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Machine deployment name to look up.",
+			},
+			"name_regex": kkp.NameRegexFilterAttribute("machine deployments"),
+			"labels": dschema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Labels the machine deployment must carry. When not set, all labels of the matched machine deployment.",
+			},
+			"creation_time": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Machine deployment creation timestamp (RFC3339).",
+			},
+			"replicas": dschema.Int64Attribute{
+				Computed:    true,
+				Description: "Desired number of replicas.",
+			},
+			"ready_replicas": dschema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of ready replicas.",
+			},
+		},
+	}
+}
+
+func (d *dataSourceMachineDeployment) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.ConfigureDataSource(req, resp)
+}
+
+func (d *dataSourceMachineDeployment) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.ValidateDataSourceBase(resp) {
+		return
+	}
+
+	var config machineDeploymentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	clusterID := kkp.TrimmedStringValue(config.ClusterID)
+	if clusterID == "" {
+		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id is required to look up a machine deployment")
+		return
+	}
+
+	filter := kkp.NewFilter(ctx, config.Name, config.NameRegex, config.Labels, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machineDeployments, err := fetchMachineDeployments(ctx, d.Client, projectID, clusterID)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list machine deployments", err)
+		return
+	}
+
+	id := kkp.TrimmedStringValue(config.ID)
+	var match *models.NodeDeployment
+	names := make([]string, 0, 1)
+	for _, md := range filterMachineDeployments(machineDeployments, filter) {
+		if id != "" && md.ID != id {
+			continue
+		}
+		match = md
+		names = append(names, md.Name)
+	}
+	if !kkp.CheckSingleMatch(&resp.Diagnostics, "machine deployment", names) {
+		return
+	}
+	summary := convertMachineDeploymentToSummary(match, clusterID)
+
+	state := config
+	state.ID = summary.ID
+	state.ProjectID = types.StringValue(projectID)
+	state.ClusterID = types.StringValue(clusterID)
+	state.Name = summary.Name
+	state.CreationTime = summary.CreationTime
+	state.Replicas = summary.Replicas
+	state.ReadyReplicas = summary.ReadyReplicas
+	// A labels filter given in the configuration is kept as written.
+	if config.Labels.IsNull() {
+		state.Labels = summary.Labels
+	}
+
+	tflog.Info(ctx, "found machine deployment", map[string]any{
+		"project_id":            projectID,
+		"cluster_id":            clusterID,
+		"machine_deployment_id": summary.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -16,7 +16,11 @@ type dataSourceMachineDeployments struct {
 
 type machineDeploymentsDataSourceModel struct {
 	ID                 types.String               `tfsdk:"id"`
+	ProjectID          types.String               `tfsdk:"project_id"`
 	ClusterID          types.String               `tfsdk:"cluster_id"`
+	Name               types.String               `tfsdk:"name"`       // optional filter: exact name
+	NameRegex          types.String               `tfsdk:"name_regex"` // optional filter: regular expression on the name
+	Labels             types.Map                  `tfsdk:"labels"`     // optional filter: all labels must match
 	MachineDeployments []machineDeploymentSummary `tfsdk:"machine_deployments"`
 }
 
@@ -29,3 +33,21 @@ type machineDeploymentSummary struct {
 	ReadyReplicas types.Int64  `tfsdk:"ready_replicas"`
 	Labels        types.Map    `tfsdk:"labels"`
 }
+
+// ---------- Singular data source ----------
+
+type dataSourceMachineDeployment struct {
+	kkp.DataSourceBase
+}
+
+type machineDeploymentDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`         // optional lookup, computed otherwise
+	ProjectID     types.String `tfsdk:"project_id"` // optional, defaults to the provider project
+	ClusterID     types.String `tfsdk:"cluster_id"` // required
+	Name          types.String `tfsdk:"name"`       // optional lookup, computed otherwise
+	NameRegex     types.String `tfsdk:"name_regex"` // optional filter
+	Labels        types.Map    `tfsdk:"labels"`     // optional filter, computed otherwise
+	CreationTime  types.String `tfsdk:"creation_time"`
+	Replicas      types.Int64  `tfsdk:"replicas"`
+	ReadyReplicas types.Int64  `tfsdk:"ready_replicas"`
+}
@@ -0,0 +1,184 @@
+// Package preset_v2 implements the Terraform data source for KKP presets.
+package preset_v2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	presetapi "github.com/kubermatic/go-kubermatic/client/preset"
+	"github.com/kubermatic/go-kubermatic/models"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var _ datasource.DataSource = &dataSourcePresets{}
+var _ datasource.DataSourceWithConfigure = &dataSourcePresets{}
+
+// NewDataSource creates a new preset v2 data source.
+func NewDataSource() datasource.DataSource {
+	return &dataSourcePresets{}
+}
+
+func (d *dataSourcePresets) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_presets_v2"
+}
+
+func (d *dataSourcePresets) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description: "Retrieves the presets available to a KKP project, optionally for one cloud provider and datacenter.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Data source identifier.",
+			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"cloud": dschema.StringAttribute{
+				Optional:    true,
+				Description: "Only return presets with credentials for this provider (e.g. openstack, aws, azure, vsphere).",
+			},
+			"datacenter": dschema.StringAttribute{
+				Optional:    true,
+				Description: "Only return presets usable in this datacenter. Requires cloud.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("cloud")),
+				},
+			},
+			"include_disabled": dschema.BoolAttribute{
+				Optional:    true,
+				Description: "Also return disabled presets (default false).",
+			},
+			"presets": dschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching presets, sorted by name.",
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"name": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Preset name, as used by the preset attribute of kkp_cluster_v2.",
+						},
+						"enabled": dschema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the preset is enabled.",
+						},
+						"providers": dschema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Providers the preset holds credentials for.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourcePresets) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.ConfigureDataSource(req, resp)
+}
+
+func (d *dataSourcePresets) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.ValidateDataSourceBase(resp) {
+		return
+	}
+
+	var config presetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	cloud := kkp.TrimmedStringValue(config.Cloud)
+	includeDisabled := kkp.BoolValueOrDefault(config.IncludeDisabled, false)
+
+	var list *models.PresetList
+	if cloud != "" {
+		params := presetapi.NewListProjectProviderPresetsParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithProviderName(cloud).
+			WithDisabled(&includeDisabled)
+		if dc := kkp.TrimmedStringValue(config.Datacenter); dc != "" {
+			params = params.WithDatacenter(&dc)
+		}
+		out, err := d.Client.Presets.ListProjectProviderPresets(params, nil)
+		if err != nil {
+			kkp.AddAPIError(&resp.Diagnostics, "Failed to list presets", err)
+			return
+		}
+		list = out.Payload
+	} else {
+		out, err := d.Client.Presets.ListProjectPresets(
+			presetapi.NewListProjectPresetsParams().WithContext(ctx).
+				WithProjectID(projectID).
+				WithDisabled(&includeDisabled),
+			nil,
+		)
+		if err != nil {
+			kkp.AddAPIError(&resp.Diagnostics, "Failed to list presets", err)
+			return
+		}
+		list = out.Payload
+	}
+
+	presets := make([]presetSummary, 0)
+	if list != nil {
+		for _, p := range list.Items {
+			if p == nil {
+				continue
+			}
+			summary, diags := convertPresetToSummary(ctx, p)
+			resp.Diagnostics.Append(diags...)
+			presets = append(presets, summary)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort presets alphabetically by name for consistent ordering
+	sort.Slice(presets, func(i, j int) bool {
+		return presets[i].Name.ValueString() < presets[j].Name.ValueString()
+	})
+
+	state := config
+	state.ID = types.StringValue("presets-" + projectID)
+	state.ProjectID = types.StringValue(projectID)
+	state.Presets = presets
+
+	tflog.Info(ctx, "successfully listed presets", map[string]any{
+		"project_id":   projectID,
+		"cloud":        cloud,
+		"preset_count": len(presets),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func convertPresetToSummary(ctx context.Context, p *models.Preset) (presetSummary, diag.Diagnostics) {
+	providers := make([]string, 0, len(p.Providers))
+	for _, pp := range p.Providers {
+		if pp != nil && pp.Name != "" {
+			providers = append(providers, string(pp.Name))
+		}
+	}
+	sort.Strings(providers)
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, providers)
+	return presetSummary{
+		Name:      types.StringValue(p.Name),
+		Enabled:   types.BoolValue(p.Enabled),
+		Providers: list,
+	}, diags
+}
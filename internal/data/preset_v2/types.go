@@ -0,0 +1,30 @@
+package preset_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type dataSourcePresets struct {
+	kkp.DataSourceBase
+}
+
+// ---------- Local state structs to read config/state ----------
+
+type presetsDataSourceModel struct {
+	ID              types.String    `tfsdk:"id"`
+	ProjectID       types.String    `tfsdk:"project_id"`
+	Cloud           types.String    `tfsdk:"cloud"`
+	Datacenter      types.String    `tfsdk:"datacenter"`
+	IncludeDisabled types.Bool      `tfsdk:"include_disabled"`
+	Presets         []presetSummary `tfsdk:"presets"`
+}
+
+type presetSummary struct {
+	Name      types.String `tfsdk:"name"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Providers types.List   `tfsdk:"providers"`
+}
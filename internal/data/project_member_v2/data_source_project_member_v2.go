@@ -0,0 +1,145 @@
+// Package project_member_v2 implements the Terraform data source for KKP project members.
+package project_member_v2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	userapi "github.com/kubermatic/go-kubermatic/client/users"
+	"github.com/kubermatic/go-kubermatic/models"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var _ datasource.DataSource = &dataSourceProjectMembers{}
+var _ datasource.DataSourceWithConfigure = &dataSourceProjectMembers{}
+
+// NewDataSource creates a new project member v2 data source.
+func NewDataSource() datasource.DataSource {
+	return &dataSourceProjectMembers{}
+}
+
+func (d *dataSourceProjectMembers) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_members_v2"
+}
+
+func (d *dataSourceProjectMembers) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description: "Retrieves the users that are members of a KKP project.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Data source identifier.",
+			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"members": dschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Project members, sorted by email.",
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"id": dschema.StringAttribute{
+							Computed:    true,
+							Description: "KKP user ID.",
+						},
+						"name": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the user.",
+						},
+						"email": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Email address of the user.",
+						},
+						"group": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Project group of the user (owners, editors, viewers or projectmanagers).",
+						},
+						"creation_time": dschema.StringAttribute{
+							Computed:    true,
+							Description: "User creation timestamp (RFC3339).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceProjectMembers) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.ConfigureDataSource(req, resp)
+}
+
+func (d *dataSourceProjectMembers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.ValidateDataSourceBase(resp) {
+		return
+	}
+
+	var config projectMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	out, err := d.Client.Users.GetUsersForProject(
+		userapi.NewGetUsersForProjectParams().WithContext(ctx).WithProjectID(projectID),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list project members", err)
+		return
+	}
+
+	members := make([]memberSummary, 0)
+	if out != nil {
+		for _, u := range out.Payload {
+			if u == nil {
+				continue
+			}
+			members = append(members, convertUserToSummary(u, projectID))
+		}
+	}
+
+	// Sort members by email for consistent ordering
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Email.ValueString() < members[j].Email.ValueString()
+	})
+
+	state := projectMembersDataSourceModel{
+		ID:        types.StringValue("project-members-" + projectID),
+		ProjectID: types.StringValue(projectID),
+		Members:   members,
+	}
+
+	tflog.Info(ctx, "successfully listed project members", map[string]any{
+		"project_id":   projectID,
+		"member_count": len(members),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func convertUserToSummary(u *models.User, projectID string) memberSummary {
+	summary := memberSummary{
+		ID:    types.StringValue(u.ID),
+		Name:  types.StringValue(u.Name),
+		Email: types.StringValue(u.Email),
+	}
+	for _, p := range u.Projects {
+		if p != nil && p.ID == projectID {
+			summary.Group = types.StringValue(kkp.ProjectRole(p.GroupPrefix, projectID))
+			break
+		}
+	}
+	if !u.CreationTimestamp.IsZero() {
+		summary.CreationTime = types.StringValue(u.CreationTimestamp.String())
+	}
+	return summary
+}
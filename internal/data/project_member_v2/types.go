@@ -0,0 +1,29 @@
+package project_member_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type dataSourceProjectMembers struct {
+	kkp.DataSourceBase
+}
+
+// ---------- Local state structs to read config/state ----------
+
+type projectMembersDataSourceModel struct {
+	ID        types.String    `tfsdk:"id"`
+	ProjectID types.String    `tfsdk:"project_id"`
+	Members   []memberSummary `tfsdk:"members"`
+}
+
+type memberSummary struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Email        types.String `tfsdk:"email"`
+	Group        types.String `tfsdk:"group"`
+	CreationTime types.String `tfsdk:"creation_time"`
+}
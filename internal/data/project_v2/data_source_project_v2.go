@@ -0,0 +1,171 @@
+// Package project_v2 implements the Terraform data source for KKP projects.
+package project_v2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var _ datasource.DataSource = &dataSourceProjects{}
+var _ datasource.DataSourceWithConfigure = &dataSourceProjects{}
+
+// NewDataSource creates a new project v2 data source.
+func NewDataSource() datasource.DataSource {
+	return &dataSourceProjects{}
+}
+
+func (d *dataSourceProjects) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects_v2"
+}
+
+func (d *dataSourceProjects) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description: "Retrieves the KKP projects visible to the provider's credentials, optionally filtered by name and labels.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Computed:    true,
+				Description: "Data source identifier.",
+			},
+			"name": dschema.StringAttribute{
+				Optional:    true,
+				Description: "Only return projects with exactly this name.",
+			},
+			"labels": dschema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return projects that carry all of these labels.",
+			},
+			"projects": dschema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching projects, sorted by name.",
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"id": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Project ID.",
+						},
+						"name": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Project name.",
+						},
+						"status": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Project status (Active, Inactive or Terminating).",
+						},
+						"owners": dschema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Email addresses of the project owners.",
+						},
+						"labels": dschema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Project labels as key-value pairs.",
+						},
+						"creation_time": dschema.StringAttribute{
+							Computed:    true,
+							Description: "Project creation timestamp (RFC3339).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceProjects) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.ConfigureDataSource(req, resp)
+}
+
+func (d *dataSourceProjects) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.ValidateDataSourceBase(resp) {
+		return
+	}
+
+	var config projectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := kkp.TrimmedStringValue(config.Name)
+	wantLabels := map[string]string{}
+	if !config.Labels.IsNull() && !config.Labels.IsUnknown() {
+		resp.Diagnostics.Append(config.Labels.ElementsAs(ctx, &wantLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	out, err := d.Client.Project.ListProjects(kapi.NewListProjectsParams().WithContext(ctx), nil)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list projects", err)
+		return
+	}
+
+	projects := make([]projectSummary, 0)
+	if out != nil {
+		for _, p := range out.Payload {
+			if p == nil || (name != "" && p.Name != name) || !kkp.HasLabels(p.Labels, wantLabels) {
+				continue
+			}
+			summary, diags := convertProjectToSummary(ctx, p)
+			resp.Diagnostics.Append(diags...)
+			projects = append(projects, summary)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort by name, then ID, for consistent ordering
+	sort.Slice(projects, func(i, j int) bool {
+		if projects[i].Name.ValueString() != projects[j].Name.ValueString() {
+			return projects[i].Name.ValueString() < projects[j].Name.ValueString()
+		}
+		return projects[i].ID.ValueString() < projects[j].ID.ValueString()
+	})
+
+	state := config
+	state.ID = types.StringValue("projects")
+	state.Projects = projects
+
+	tflog.Info(ctx, "successfully listed projects", map[string]any{
+		"project_count": len(projects),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func convertProjectToSummary(ctx context.Context, p *models.Project) (projectSummary, diag.Diagnostics) {
+	summary := projectSummary{
+		ID:     types.StringValue(p.ID),
+		Name:   types.StringValue(p.Name),
+		Status: types.StringValue(p.Status),
+		Labels: kkp.ConvertLabelsToTerraform(p.Labels),
+	}
+	if !p.CreationTimestamp.IsZero() {
+		summary.CreationTime = types.StringValue(p.CreationTimestamp.String())
+	}
+
+	owners := make([]string, 0, len(p.Owners))
+	for _, o := range p.Owners {
+		if o != nil && o.Email != "" {
+			owners = append(owners, o.Email)
+		}
+	}
+	ownerList, diags := types.ListValueFrom(ctx, types.StringType, owners)
+	summary.Owners = ownerList
+	return summary, diags
+}
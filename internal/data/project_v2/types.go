@@ -0,0 +1,31 @@
+package project_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type dataSourceProjects struct {
+	kkp.DataSourceBase
+}
+
+// ---------- Local state structs to read config/state ----------
+
+type projectsDataSourceModel struct {
+	ID       types.String     `tfsdk:"id"`
+	Name     types.String     `tfsdk:"name"`   // optional filter: exact project name
+	Labels   types.Map        `tfsdk:"labels"` // optional filter: all labels must match
+	Projects []projectSummary `tfsdk:"projects"`
+}
+
+type projectSummary struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Status       types.String `tfsdk:"status"`
+	Owners       types.List   `tfsdk:"owners"`
+	Labels       types.Map    `tfsdk:"labels"`
+	CreationTime types.String `tfsdk:"creation_time"`
+}
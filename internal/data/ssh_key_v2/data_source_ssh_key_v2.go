@@ -13,6 +13,8 @@ import (
 
 	kapi "github.com/kubermatic/go-kubermatic/client/project"
 	"github.com/kubermatic/go-kubermatic/models"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
 )
 
 var _ datasource.DataSource = &dataSourceSSHKeys{}
@@ -29,15 +31,18 @@ func (d *dataSourceSSHKeys) Metadata(_ context.Context, req datasource.MetadataR
 
 func (d *dataSourceSSHKeys) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = dschema.Schema{
-		Description: "Retrieves a list of SSH keys from KKP using V2 API.",
+		Description: "Retrieves a list of SSH keys from KKP using V2 API, optionally filtered by name.",
 		Attributes: map[string]dschema.Attribute{
 			"id": dschema.StringAttribute{
 				Computed:    true,
 				Description: "Data source identifier.",
 			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"name":       kkp.NameFilterAttribute("SSH keys"),
+			"name_regex": kkp.NameRegexFilterAttribute("SSH keys"),
 			"ssh_keys": dschema.ListNestedAttribute{
 				Computed:    true,
-				Description: "List of SSH keys available in the project.",
+				Description: "Matching SSH keys in the project, sorted by name.",
 				NestedObject: dschema.NestedAttributeObject{
 					Attributes: map[string]dschema.Attribute{
 						"id": dschema.StringAttribute{
@@ -86,10 +91,19 @@ func (d *dataSourceSSHKeys) Read(ctx context.Context, req datasource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	filter := kkp.NewFilter(ctx, config.Name, config.NameRegex, types.MapNull(types.StringType), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	sshKeysPayload, err := d.fetchSSHKeys()
+	sshKeysPayload, err := fetchSSHKeys(ctx, d.Client, projectID)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to list SSH keys", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list SSH keys", err)
 		return
 	}
 
@@ -98,24 +112,24 @@ func (d *dataSourceSSHKeys) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	sshKeys := d.convertSSHKeysToSummaries(sshKeysPayload)
+	sshKeys := convertSSHKeysToSummaries(filterSSHKeys(sshKeysPayload, filter))
 
-	state := sshKeysDataSourceModel{
-		ID:      types.StringValue("ssh-keys-" + d.DefaultProjectID),
-		SSHKeys: sshKeys,
-	}
+	state := config
+	state.ID = types.StringValue("ssh-keys-" + projectID)
+	state.ProjectID = types.StringValue(projectID)
+	state.SSHKeys = sshKeys
 
 	tflog.Info(ctx, "successfully listed SSH keys", map[string]any{
-		"project_id":    d.DefaultProjectID,
+		"project_id":    projectID,
 		"ssh_key_count": len(sshKeys),
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (d *dataSourceSSHKeys) fetchSSHKeys() ([]*models.SSHKey, error) {
-	pcli := kapi.New(d.Client.Transport, nil)
-	params := kapi.NewListSSHKeysParams().WithProjectID(d.DefaultProjectID)
+func fetchSSHKeys(ctx context.Context, client *kkp.Client, projectID string) ([]*models.SSHKey, error) {
+	pcli := client.Project
+	params := kapi.NewListSSHKeysParams().WithContext(ctx).WithProjectID(projectID)
 	resp, err := pcli.ListSSHKeys(params, nil)
 	if err != nil {
 		return nil, err
@@ -123,14 +137,25 @@ func (d *dataSourceSSHKeys) fetchSSHKeys() ([]*models.SSHKey, error) {
 	return resp.Payload, nil
 }
 
-func (d *dataSourceSSHKeys) convertSSHKeysToSummaries(sshKeys []*models.SSHKey) []sshKeySummary {
+// filterSSHKeys returns the non-nil SSH keys passing the filter.
+func filterSSHKeys(sshKeys []*models.SSHKey, filter kkp.Filter) []*models.SSHKey {
+	matched := make([]*models.SSHKey, 0, len(sshKeys))
+	for _, sshKey := range sshKeys {
+		if sshKey != nil && filter.Match(sshKey.Name, nil) {
+			matched = append(matched, sshKey)
+		}
+	}
+	return matched
+}
+
+func convertSSHKeysToSummaries(sshKeys []*models.SSHKey) []sshKeySummary {
 	summaries := make([]sshKeySummary, 0, len(sshKeys))
 
 	for _, sshKey := range sshKeys {
 		if sshKey == nil {
 			continue
 		}
-		summaries = append(summaries, d.convertSSHKeyToSummary(sshKey))
+		summaries = append(summaries, convertSSHKeyToSummary(sshKey))
 	}
 
 	// Sort SSH keys alphabetically by name for consistent ordering
@@ -141,7 +166,7 @@ func (d *dataSourceSSHKeys) convertSSHKeysToSummaries(sshKeys []*models.SSHKey)
 	return summaries
 }
 
-func (d *dataSourceSSHKeys) convertSSHKeyToSummary(sshKey *models.SSHKey) sshKeySummary {
+func convertSSHKeyToSummary(sshKey *models.SSHKey) sshKeySummary {
 	summary := sshKeySummary{
 		ID:   types.StringValue(sshKey.ID),
 		Name: types.StringValue(sshKey.Name),
@@ -0,0 +1,119 @@
+package ssh_key_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/kubermatic/go-kubermatic/models"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var _ datasource.DataSource = &dataSourceSSHKey{}
+var _ datasource.DataSourceWithConfigure = &dataSourceSSHKey{}
+
+// NewSingularDataSource creates a new data source looking up a single SSH key.
+func NewSingularDataSource() datasource.DataSource {
+	return &dataSourceSSHKey{}
+}
+
+func (d *dataSourceSSHKey) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_v2"
+}
+
+func (d *dataSourceSSHKey) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dschema.Schema{
+		Description: "Looks up a single project SSH key by ID or name. Fails unless exactly one key matches.",
+		Attributes: map[string]dschema.Attribute{
+			"id": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SSH key ID to look up.",
+			},
+			"project_id": kkp.ProjectIDDataSourceAttribute(),
+			"name": dschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SSH key name to look up.",
+			},
+			"name_regex": kkp.NameRegexFilterAttribute("SSH keys"),
+			"fingerprint": dschema.StringAttribute{
+				Computed:    true,
+				Description: "SSH key fingerprint.",
+			},
+			"public_key": dschema.StringAttribute{
+				Computed:    true,
+				Description: "SSH public key content.",
+			},
+			"creation_time": dschema.StringAttribute{
+				Computed:    true,
+				Description: "SSH key creation timestamp (RFC3339).",
+			},
+		},
+	}
+}
+
+func (d *dataSourceSSHKey) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.ConfigureDataSource(req, resp)
+}
+
+func (d *dataSourceSSHKey) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.ValidateDataSourceBase(resp) {
+		return
+	}
+
+	var config sshKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, ok := d.ResolveProjectID(config.ProjectID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	filter := kkp.NewFilter(ctx, config.Name, config.NameRegex, types.MapNull(types.StringType), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshKeys, err := fetchSSHKeys(ctx, d.Client, projectID)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Failed to list SSH keys", err)
+		return
+	}
+
+	id := kkp.TrimmedStringValue(config.ID)
+	var match *models.SSHKey
+	names := make([]string, 0, 1)
+	for _, sshKey := range filterSSHKeys(sshKeys, filter) {
+		if id != "" && sshKey.ID != id {
+			continue
+		}
+		match = sshKey
+		names = append(names, sshKey.Name)
+	}
+	if !kkp.CheckSingleMatch(&resp.Diagnostics, "SSH key", names) {
+		return
+	}
+	summary := convertSSHKeyToSummary(match)
+
+	state := config
+	state.ID = summary.ID
+	state.ProjectID = types.StringValue(projectID)
+	state.Name = summary.Name
+	state.Fingerprint = summary.Fingerprint
+	state.PublicKey = summary.PublicKey
+	state.CreationTime = summary.CreationTime
+
+	tflog.Info(ctx, "found SSH key", map[string]any{
+		"project_id": projectID,
+		"ssh_key_id": summary.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
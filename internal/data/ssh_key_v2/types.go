@@ -15,8 +15,11 @@ type dataSourceSSHKeys struct {
 // ---------- Local state structs to read config/state ----------
 
 type sshKeysDataSourceModel struct {
-	ID      types.String    `tfsdk:"id"`
-	SSHKeys []sshKeySummary `tfsdk:"ssh_keys"`
+	ID        types.String    `tfsdk:"id"`
+	ProjectID types.String    `tfsdk:"project_id"`
+	Name      types.String    `tfsdk:"name"`       // optional filter: exact key name
+	NameRegex types.String    `tfsdk:"name_regex"` // optional filter: regular expression on the name
+	SSHKeys   []sshKeySummary `tfsdk:"ssh_keys"`
 }
 
 type sshKeySummary struct {
@@ -27,3 +30,19 @@ type sshKeySummary struct {
 	CreationTime types.String `tfsdk:"creation_time"`
 	Labels       types.Map    `tfsdk:"labels"`
 }
+
+// ---------- Singular data source ----------
+
+type dataSourceSSHKey struct {
+	kkp.DataSourceBase
+}
+
+type sshKeyDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`         // optional lookup, computed otherwise
+	ProjectID    types.String `tfsdk:"project_id"` // optional, defaults to the provider project
+	Name         types.String `tfsdk:"name"`       // optional lookup, computed otherwise
+	NameRegex    types.String `tfsdk:"name_regex"` // optional filter
+	Fingerprint  types.String `tfsdk:"fingerprint"`
+	PublicKey    types.String `tfsdk:"public_key"`
+	CreationTime types.String `tfsdk:"creation_time"`
+}
@@ -0,0 +1,205 @@
+package kkp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+// DefaultCacheTTL is how long a successful list or get response is served to other callers.
+// It is shorter than every poll interval, so it only merges polls that happen at about the same time.
+const DefaultCacheTTL = 2 * time.Second
+
+// requestCache deduplicates identical in-flight read requests and briefly caches their
+// successful results. It is owned by a single Client, i.e. one provider instance.
+//
+// Every mutating request sent through the client invalidates the whole cache when it
+// starts and again when it completes, and reads that were in flight at that time are not
+// cached, so a read issued after a write never observes pre-write data from the cache.
+type requestCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	done    chan struct{} // closed when the request has completed
+	value   any
+	err     error
+	fetched time.Time
+}
+
+func newRequestCache(ttl time.Duration) *requestCache {
+	return &requestCache{ttl: ttl, entries: map[string]*cacheEntry{}}
+}
+
+// do returns the result for key, joining an identical in-flight request or serving a fresh
+// cached result when possible, and otherwise calling fetch. Errors are never cached.
+func (c *requestCache) do(ctx context.Context, key string, fetch func(context.Context) (any, error)) (any, error) {
+	for {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		if ok {
+			select {
+			case <-entry.done:
+				if entry.err == nil && time.Since(entry.fetched) < c.ttl {
+					c.mu.Unlock()
+					return entry.value, nil
+				}
+				ok = false // expired or failed; replace below
+			default:
+			}
+		}
+		if !ok {
+			entry = &cacheEntry{done: make(chan struct{})}
+			c.entries[key] = entry
+			c.mu.Unlock()
+			return c.run(ctx, key, entry, fetch)
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if entry.err == nil {
+			return entry.value, nil
+		}
+		// The caller that issued the shared request gave up; issue our own instead of
+		// inheriting its cancellation.
+		if errors.Is(entry.err, context.Canceled) || errors.Is(entry.err, context.DeadlineExceeded) {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+		return nil, entry.err
+	}
+}
+
+// run performs fetch on behalf of all callers waiting on entry.
+func (c *requestCache) run(ctx context.Context, key string, entry *cacheEntry, fetch func(context.Context) (any, error)) (any, error) {
+	value, err := fetch(ctx)
+
+	c.mu.Lock()
+	entry.value, entry.err, entry.fetched = value, err, time.Now()
+	if err != nil && c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.done)
+
+	return value, err
+}
+
+// invalidate drops every entry. In-flight requests still deliver their result to the
+// callers already waiting on them, but it is not served to anyone else.
+func (c *requestCache) invalidate() {
+	c.mu.Lock()
+	clear(c.entries)
+	c.mu.Unlock()
+}
+
+// invalidatingTransport invalidates the cache around every request that may change state.
+type invalidatingTransport struct {
+	next  http.RoundTripper
+	cache *requestCache
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *invalidatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(req)
+	}
+	t.cache.invalidate()
+	defer t.cache.invalidate()
+	return t.next.RoundTrip(req)
+}
+
+// ---------- Shared reads ----------
+//
+// The slices and models returned below may be shared with other callers and must be
+// treated as read-only.
+
+// ListClusters lists the clusters of a project.
+func (c *Client) ListClusters(ctx context.Context, projectID string) ([]*models.Cluster, error) {
+	v, err := c.cached(ctx, "clusters/"+projectID, func(ctx context.Context) (any, error) {
+		resp, err := c.Project.ListClustersV2(kapi.NewListClustersV2Params().WithContext(ctx).WithProjectID(projectID), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil || resp.Payload == nil {
+			return []*models.Cluster(nil), nil
+		}
+		return resp.Payload.Clusters, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*models.Cluster), nil
+}
+
+// ListMachineDeployments lists the machine deployments of a cluster.
+func (c *Client) ListMachineDeployments(ctx context.Context, projectID, clusterID string) ([]*models.NodeDeployment, error) {
+	key := strings.Join([]string{"machinedeployments", projectID, clusterID}, "/")
+	v, err := c.cached(ctx, key, func(ctx context.Context) (any, error) {
+		resp, err := c.Project.ListMachineDeployments(
+			kapi.NewListMachineDeploymentsParams().WithContext(ctx).
+				WithProjectID(projectID).
+				WithClusterID(clusterID),
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return []*models.NodeDeployment(nil), nil
+		}
+		return resp.Payload, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*models.NodeDeployment), nil
+}
+
+// GetClusterHealth fetches the health of a cluster.
+func (c *Client) GetClusterHealth(ctx context.Context, projectID, clusterID string) (*models.ClusterHealth, error) {
+	key := strings.Join([]string{"health", projectID, clusterID}, "/")
+	v, err := c.cached(ctx, key, func(ctx context.Context) (any, error) {
+		resp, err := c.Project.GetClusterHealthV2(
+			kapi.NewGetClusterHealthV2Params().WithContext(ctx).
+				WithProjectID(projectID).
+				WithClusterID(clusterID),
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			return (*models.ClusterHealth)(nil), nil
+		}
+		return resp.Payload, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*models.ClusterHealth), nil
+}
+
+// cached routes a read through the client's request cache, or calls fetch directly for
+// clients that were not built by NewHTTPClient.
+func (c *Client) cached(ctx context.Context, key string, fetch func(context.Context) (any, error)) (any, error) {
+	if c.cache == nil {
+		return fetch(ctx)
+	}
+	return c.cache.do(ctx, key, fetch)
+}
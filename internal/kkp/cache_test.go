@@ -0,0 +1,244 @@
+package kkp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// countingFetch returns a fetch function that counts its calls and returns the call number.
+func countingFetch(calls *atomic.Int32) func(context.Context) (any, error) {
+	return func(context.Context) (any, error) {
+		return int(calls.Add(1)), nil
+	}
+}
+
+func TestRequestCacheDeduplicatesInFlightRequests(t *testing.T) {
+	// A zero TTL means only joining an in-flight request can avoid a second fetch.
+	c := newRequestCache(0)
+	release := make(chan struct{})
+	var calls atomic.Int32
+	fetch := func(context.Context) (any, error) {
+		calls.Add(1)
+		<-release
+		return "clusters", nil
+	}
+
+	const callers = 5
+	var wg sync.WaitGroup
+	results := make(chan any, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := c.do(context.Background(), "key", fetch)
+			if err != nil {
+				t.Errorf("do: %v", err)
+			}
+			results <- v
+		}()
+	}
+	time.Sleep(50 * time.Millisecond) // let every caller join the in-flight request
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("fetch called %d times, want 1", n)
+	}
+	for v := range results {
+		if v != "clusters" {
+			t.Errorf("result = %v, want clusters", v)
+		}
+	}
+}
+
+func TestRequestCacheExpiresAfterTTL(t *testing.T) {
+	c := newRequestCache(time.Hour)
+	var calls atomic.Int32
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if v, _ := c.do(ctx, "key", countingFetch(&calls)); v != 1 {
+			t.Fatalf("call %d: got %v, want cached result 1", i, v)
+		}
+	}
+
+	c.mu.Lock()
+	c.entries["key"].fetched = time.Now().Add(-2 * time.Hour)
+	c.mu.Unlock()
+
+	if v, _ := c.do(ctx, "key", countingFetch(&calls)); v != 2 {
+		t.Fatalf("after expiry: got %v, want a fresh result 2", v)
+	}
+}
+
+func TestRequestCacheDoesNotCacheErrors(t *testing.T) {
+	c := newRequestCache(time.Hour)
+	ctx := context.Background()
+	boom := errors.New("boom")
+
+	if _, err := c.do(ctx, "key", func(context.Context) (any, error) { return nil, boom }); !errors.Is(err, boom) {
+		t.Fatalf("first call: got %v, want boom", err)
+	}
+	v, err := c.do(ctx, "key", func(context.Context) (any, error) { return "ok", nil })
+	if err != nil || v != "ok" {
+		t.Fatalf("second call: got %v, %v; want ok", v, err)
+	}
+}
+
+func TestRequestCacheWaiterDoesNotInheritCancellation(t *testing.T) {
+	c := newRequestCache(time.Hour)
+	started := make(chan struct{})
+	var calls atomic.Int32
+	fetch := func(ctx context.Context) (any, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return "fresh", nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := c.do(ctx, "key", fetch)
+		firstErr <- err
+	}()
+	<-started
+
+	type result struct {
+		v   any
+		err error
+	}
+	waiter := make(chan result, 1)
+	go func() {
+		v, err := c.do(context.Background(), "key", fetch)
+		waiter <- result{v, err}
+	}()
+	time.Sleep(50 * time.Millisecond) // let the waiter join the in-flight request
+	cancel()
+
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("issuing caller: got %v, want context.Canceled", err)
+	}
+	select {
+	case r := <-waiter:
+		if r.err != nil || r.v != "fresh" {
+			t.Fatalf("waiter: got %v, %v; want its own fresh result", r.v, r.err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter did not return")
+	}
+}
+
+func TestRequestCacheWaiterHonoursOwnContext(t *testing.T) {
+	c := newRequestCache(time.Hour)
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	go func() {
+		_, _ = c.do(context.Background(), "key", func(context.Context) (any, error) {
+			close(started)
+			<-release
+			return "late", nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.do(ctx, "key", countingFetch(new(atomic.Int32))); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestInvalidatingTransport(t *testing.T) {
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			c := newRequestCache(time.Hour)
+			ctx := context.Background()
+			var calls atomic.Int32
+			if _, err := c.do(ctx, "key", countingFetch(&calls)); err != nil {
+				t.Fatal(err)
+			}
+
+			transport := &invalidatingTransport{cache: c, next: roundTripFunc(func(*http.Request) (*http.Response, error) {
+				// Invalidated before the write is sent ...
+				if v, _ := c.do(ctx, "key", countingFetch(&calls)); v != 2 {
+					t.Errorf("read during write: got %v, want a fresh result 2", v)
+				}
+				// ... and the result of a read racing with the write is dropped afterwards.
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			})}
+
+			req, _ := http.NewRequest(method, "https://kkp.example.com/api/v2/projects/p/clusters", nil)
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatal(err)
+			}
+			if v, _ := c.do(ctx, "key", countingFetch(&calls)); v != 3 {
+				t.Fatalf("read after write: got %v, want a fresh result 3", v)
+			}
+		})
+	}
+}
+
+func TestInvalidatingTransportDropsInFlightRead(t *testing.T) {
+	c := newRequestCache(time.Hour)
+	ctx := context.Background()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = c.do(ctx, "key", func(context.Context) (any, error) {
+			close(started)
+			<-release
+			return "stale", nil
+		})
+	}()
+	<-started
+
+	transport := &invalidatingTransport{cache: c, next: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})}
+	req, _ := http.NewRequest(http.MethodDelete, "https://kkp.example.com/api/v2/projects/p/clusters/c", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	<-done
+
+	if v, _ := c.do(ctx, "key", func(context.Context) (any, error) { return "fresh", nil }); v != "fresh" {
+		t.Fatalf("read after write: got %v, want fresh", v)
+	}
+}
+
+func TestInvalidatingTransportKeepsCacheOnReads(t *testing.T) {
+	c := newRequestCache(time.Hour)
+	ctx := context.Background()
+	var calls atomic.Int32
+	if _, err := c.do(ctx, "key", countingFetch(&calls)); err != nil {
+		t.Fatal(err)
+	}
+
+	transport := &invalidatingTransport{cache: c, next: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})}
+	req, _ := http.NewRequest(http.MethodGet, "https://kkp.example.com/api/v2/projects/p/clusters", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := c.do(ctx, "key", countingFetch(&calls)); v != 1 {
+		t.Fatalf("got %v, want cached result 1", v)
+	}
+}
@@ -3,8 +3,10 @@ package kkp
 
 import (
 	"context"
+	"crypto/tls"
 	"io"
 	"net/http"
+	"time"
 
 	runtime "github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
@@ -13,17 +15,17 @@ import (
 )
 
 // AuthenticateRequest implements the runtime.ClientAuthInfoWriter interface.
-// It sets the Authorization header with the Bearer token, User-Agent, and any extra headers
+// It sets the User-Agent and any extra headers; the Authorization header is set by bearerTransport.
 func (a *headerAuthWriter) AuthenticateRequest(rq runtime.ClientRequest, _ strfmt.Registry) error {
+	// Generated params carry go-openapi's 30s default timeout, which would cap every call below
+	// the configured timeout. Clear it so that the HTTP client's Config.Timeout applies.
+	_ = rq.SetTimeout(0)
 	if a.userAgent != "" {
 		_ = rq.SetHeaderParam("User-Agent", a.userAgent)
 	}
 	for k, v := range a.extraHeader {
 		_ = rq.SetHeaderParam(k, v)
 	}
-	if a.token != "" {
-		_ = rq.SetHeaderParam("Authorization", "Bearer "+a.token)
-	}
 	return nil
 }
 
@@ -34,26 +36,43 @@ func NewHTTPClient(cfg Config) (*Client, error) {
 		return nil, err
 	}
 
-	tlsCfg, err := buildTLSConfig(cfg.InsecureSkipVerify, cfg.CAFile)
+	tlsCfg, err := buildTLSConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
-	httpClient := newHTTPClient(cfg.Timeout, tlsCfg)
-
-	rt := httptransport.NewWithClient(baseURL.Host, baseURL.Path, []string{baseURL.Scheme}, httpClient)
-	rt.DefaultAuthentication = &headerAuthWriter{
-		token:       cfg.Token,
+	tokens, err := newTokenSource(cfg, tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+	auth := &headerAuthWriter{
+		tokens:      tokens,
 		userAgent:   defaultUA(cfg.UserAgent),
 		extraHeader: cfg.ExtraHeaders,
 	}
+	cache := newRequestCache(DefaultCacheTTL)
+	httpClient, err := newHTTPClient(cfg, tlsCfg, cache, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := httptransport.NewWithClient(baseURL.Host, baseURL.Path, []string{baseURL.Scheme}, httpClient)
+	rt.DefaultAuthentication = auth
 
 	api := gok.New(rt, strfmt.Default)
 
 	return &Client{
-		API:        api, // keep as `any` unless you pin the exact type
-		Transport:  rt,
-		HTTPClient: httpClient,
-		BaseURL:    baseURL,
+		API:             api,
+		Project:         api.Project,
+		Addons:          api.Addon,
+		Applications:    api.Applications,
+		Users:           api.Users,
+		ServiceAccounts: api.Serviceaccounts,
+		Tokens:          api.Tokens,
+		Presets:         api.Preset,
+		Transport:       rt,
+		HTTPClient:      httpClient,
+		BaseURL:         baseURL,
+		cache:           cache,
 	}, nil
 }
 
@@ -67,12 +86,7 @@ func (c *Client) Ping(ctx context.Context) error {
 
 	// Reuse headers from our auth writer if present.
 	if aw, ok := c.Transport.DefaultAuthentication.(*headerAuthWriter); ok {
-		if aw.token != "" {
-			req.Header.Set("Authorization", "Bearer "+aw.token)
-		}
-		for k, v := range aw.extraHeader {
-			req.Header.Set(k, v)
-		}
+		aw.setHeaders(req)
 	}
 
 	resp, err := c.HTTPClient.Do(req)
@@ -90,6 +104,37 @@ func (c *Client) Ping(ctx context.Context) error {
 	return nil
 }
 
+// newTokenSource selects the token source for cfg: OIDC, a token command or a token file when
+// configured, otherwise the static token.
+func newTokenSource(cfg Config, tlsCfg *tls.Config) (TokenSource, error) {
+	switch {
+	case cfg.OIDC != nil:
+	case len(cfg.TokenCommand) > 0:
+		return newCommandTokenSource(cfg.TokenCommand)
+	case cfg.TokenFile != "":
+		return newFileTokenSource(cfg.TokenFile), nil
+	case cfg.Token != "":
+		return StaticToken(cfg.Token), nil
+	default:
+		return nil, nil
+	}
+
+	// Talk to the issuer with the same TLS and proxy settings, but without the KKP-specific
+	// retry, throttling and caching layers.
+	proxy, err := proxyFunc(cfg.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 60 * time.Second
+	}
+	return newOIDCTokenSource(*cfg.OIDC, &http.Client{
+		Transport: &http.Transport{Proxy: proxy, TLSClientConfig: tlsCfg},
+		Timeout:   timeout,
+	})
+}
+
 type httpError struct{ status string }
 
 func (e *httpError) Error() string { return "kkp ping: " + e.status }
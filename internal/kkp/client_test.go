@@ -0,0 +1,57 @@
+package kkp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+)
+
+// slowServer answers every request with an empty cluster list after delay.
+func slowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"clusters":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClientTimeoutOverridesGeneratedDefault(t *testing.T) {
+	// Shrink go-openapi's 30s default, which generated params copy, to keep the test fast.
+	defaultTimeout := httptransport.DefaultTimeout
+	httptransport.DefaultTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { httptransport.DefaultTimeout = defaultTimeout })
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		wantErr bool
+	}{
+		{"configured timeout above the generated default", 5 * time.Second, false},
+		{"configured timeout still applies", 100 * time.Millisecond, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := slowServer(t, 300*time.Millisecond)
+			c, err := NewHTTPClient(Config{Endpoint: srv.URL, Token: "secret", Timeout: tt.timeout, MaxRetries: 0})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = c.Project.ListClustersV2(kapi.NewListClustersV2Params().WithProjectID("p"), nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListClustersV2 error = %v, want error: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
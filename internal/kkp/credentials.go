@@ -0,0 +1,199 @@
+package kkp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the bearer token for KKP API requests. Implementations must be safe
+// for concurrent use and should cache tokens until shortly before they expire.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken string
+
+// Token implements TokenSource.
+func (t StaticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// defaultTokenLifetime is assumed for tokens that carry no expiry information.
+const defaultTokenLifetime = 5 * time.Minute
+
+// tokenFetchTimeout bounds obtaining a token for a single request, on top of the request's
+// own context.
+const tokenFetchTimeout = 30 * time.Second
+
+// tokenExpiryDelta is how long before its expiry a cached token is replaced.
+const tokenExpiryDelta = time.Minute
+
+// bearerToken returns the current token, or "" when no token source is configured.
+func (a *headerAuthWriter) bearerToken(ctx context.Context) (string, error) {
+	if a.tokens == nil {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, tokenFetchTimeout)
+	defer cancel()
+	token, err := a.tokens.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("obtain KKP API token: %w", err)
+	}
+	return token, nil
+}
+
+// setHeaders applies the extra headers to a plain HTTP request, for calls that bypass the
+// generated client. The bearer token is added by bearerTransport.
+func (a *headerAuthWriter) setHeaders(req *http.Request) {
+	for k, v := range a.extraHeader {
+		req.Header.Set(k, v)
+	}
+}
+
+// bearerTransport sets the Authorization header on every request. The generated client does
+// not pass the request context to authentication, so the token is obtained here with the
+// context of the HTTP request: cancelling an operation also cancels a pending token fetch.
+type bearerTransport struct {
+	next http.RoundTripper
+	auth *headerAuthWriter
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.auth.bearerToken(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	if token != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// fileTokenSource reads the token from a file and re-reads it whenever the file changes,
+// e.g. when a sidecar rotates a short-lived service account token.
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	token   string
+}
+
+func newFileTokenSource(path string) *fileTokenSource {
+	return &fileTokenSource{path: path}
+}
+
+// Token implements TokenSource.
+func (s *fileTokenSource) Token(context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fi, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("token file: %w", err)
+	}
+	if s.token != "" && fi.ModTime().Equal(s.modTime) && fi.Size() == s.size {
+		return s.token, nil
+	}
+
+	data, err := os.ReadFile(s.path) // #nosec G304 -- path is configured by the user
+	if err != nil {
+		return "", fmt.Errorf("token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", s.path)
+	}
+	s.token, s.modTime, s.size = token, fi.ModTime(), fi.Size()
+	return s.token, nil
+}
+
+// commandTokenSource runs an external command to obtain a token and caches it until shortly
+// before it expires. The command prints either a Kubernetes ExecCredential JSON document
+// (status.token and status.expirationTimestamp) or the bare token; the expiry of a bare token
+// is taken from its JWT exp claim when present.
+type commandTokenSource struct {
+	argv []string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time // zero means the token does not expire
+}
+
+func newCommandTokenSource(argv []string) (*commandTokenSource, error) {
+	if len(argv) == 0 || strings.TrimSpace(argv[0]) == "" {
+		return nil, errors.New("token command is empty")
+	}
+	return &commandTokenSource{argv: argv}, nil
+}
+
+// execCredential is the subset of client.authentication.k8s.io ExecCredential we read.
+type execCredential struct {
+	Status *struct {
+		Token               string     `json:"token"`
+		ExpirationTimestamp *time.Time `json:"expirationTimestamp"`
+	} `json:"status"`
+}
+
+// Token implements TokenSource.
+func (s *commandTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Until(s.expiry) > tokenExpiryDelta) {
+		return s.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.argv[0], s.argv[1:]...) // #nosec G204 -- command is configured by the user
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("token command %s: %w: %s", s.argv[0], err, msg)
+		}
+		return "", fmt.Errorf("token command %s: %w", s.argv[0], err)
+	}
+
+	out := bytes.TrimSpace(stdout.Bytes())
+	var token string
+	var expiry time.Time
+	var cred execCredential
+	if len(out) > 0 && out[0] == '{' {
+		if err := json.Unmarshal(out, &cred); err != nil {
+			return "", fmt.Errorf("token command %s: decode ExecCredential: %w", s.argv[0], err)
+		}
+		if cred.Status == nil || cred.Status.Token == "" {
+			return "", fmt.Errorf("token command %s: ExecCredential has no status.token", s.argv[0])
+		}
+		token = cred.Status.Token
+		if cred.Status.ExpirationTimestamp != nil {
+			expiry = *cred.Status.ExpirationTimestamp
+		}
+	} else {
+		token = string(out)
+		if exp, ok := jwtExpiry(token); ok {
+			expiry = exp
+		}
+	}
+	if token == "" {
+		return "", fmt.Errorf("token command %s printed no token", s.argv[0])
+	}
+
+	s.token, s.expiry = token, expiry
+	return s.token, nil
+}
@@ -0,0 +1,226 @@
+package kkp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// blockingTokenSource waits for its context before failing, like an issuer that does not answer.
+type blockingTokenSource struct{}
+
+func (blockingTokenSource) Token(ctx context.Context) (string, error) {
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func TestBearerTransportSetsToken(t *testing.T) {
+	var got string
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("Authorization")
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+	auth := &headerAuthWriter{tokens: StaticToken("secret"), extraHeader: map[string]string{"Authorization": "Basic ignored"}}
+	rt := &bearerTransport{next: next, auth: auth}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://kkp.example.com/api/v2/projects", nil)
+	auth.setHeaders(req)
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	if got != "Bearer secret" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer secret")
+	}
+}
+
+func TestBearerTransportTokenFetchHonoursRequestContext(t *testing.T) {
+	rt := &bearerTransport{
+		next: okTransport,
+		auth: &headerAuthWriter{tokens: blockingTokenSource{}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://kkp.example.com/api/v2/projects", nil)
+
+	start := time.Now()
+	_, err := rt.RoundTrip(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("token fetch took %s, want it cancelled with the request", elapsed)
+	}
+}
+
+func TestFileTokenSourceRereadsChangedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	write := func(content string, modTime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	src := newFileTokenSource(path)
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	steps := []struct {
+		name    string
+		content string
+		modTime time.Time
+		want    string
+	}{
+		{"initial read trims whitespace", "token-a\n", base, "token-a"},
+		{"unchanged mtime and size is cached", "token-b\n", base, "token-a"},
+		{"changed size", "token-cc\n", base, "token-cc"},
+		{"changed mtime", "token-dd\n", base.Add(time.Minute), "token-dd"},
+	}
+	for _, step := range steps {
+		write(step.content, step.modTime)
+		got, err := src.Token(context.Background())
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if got != step.want {
+			t.Errorf("%s: Token = %q, want %q", step.name, got, step.want)
+		}
+	}
+}
+
+func TestFileTokenSourceErrors(t *testing.T) {
+	dir := t.TempDir()
+	tests := map[string]struct {
+		content *string
+		wantErr string
+	}{
+		"empty file":      {content: ptr(""), wantErr: "is empty"},
+		"whitespace only": {content: ptr(" \n\t"), wantErr: "is empty"},
+		"missing file":    {wantErr: "no such file"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(name, " ", "-"))
+			if tt.content != nil {
+				if err := os.WriteFile(path, []byte(*tt.content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := newFileTokenSource(path).Token(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string { return &s }
+
+// scriptTokenSource returns a token command that prints output and counts its runs.
+func scriptTokenSource(t *testing.T, output string) (*commandTokenSource, func() int) {
+	t.Helper()
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "out"), []byte(output), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	src, err := newCommandTokenSource([]string{"sh", "-c", `echo run >> "$0/count"; cat "$0/out"`, dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs := func() int {
+		data, _ := os.ReadFile(filepath.Join(dir, "count"))
+		return strings.Count(string(data), "run")
+	}
+	return src, runs
+}
+
+func execCredentialJSON(token string, expiry *time.Time) string {
+	status := map[string]any{"token": token}
+	if expiry != nil {
+		status["expirationTimestamp"] = expiry.UTC().Format(time.RFC3339)
+	}
+	out, _ := json.Marshal(map[string]any{
+		"apiVersion": "client.authentication.k8s.io/v1",
+		"kind":       "ExecCredential",
+		"status":     status,
+	})
+	return string(out)
+}
+
+func TestCommandTokenSourceOutputAndCaching(t *testing.T) {
+	later := time.Now().Add(time.Hour)
+	soon := time.Now().Add(tokenExpiryDelta / 2)
+	laterJWT := testJWT("later", later)
+	soonJWT := testJWT("soon", soon)
+	tests := []struct {
+		name     string
+		output   string
+		want     string
+		wantRuns int
+	}{
+		{"ExecCredential cached until expiry", execCredentialJSON("exec-token", &later), "exec-token", 1},
+		{"ExecCredential within expiry delta is rerun", execCredentialJSON("exec-token", &soon), "exec-token", 3},
+		{"ExecCredential without expiry never expires", execCredentialJSON("exec-token", nil), "exec-token", 1},
+		{"bare JWT cached until exp", laterJWT + "\n", laterJWT, 1},
+		{"bare JWT within expiry delta is rerun", soonJWT + "\n", soonJWT, 3},
+		{"bare opaque token never expires", "  opaque\n", "opaque", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, runs := scriptTokenSource(t, tt.output)
+			for i := 0; i < 3; i++ {
+				got, err := src.Token(context.Background())
+				if err != nil {
+					t.Fatalf("call %d: %v", i+1, err)
+				}
+				if got != tt.want {
+					t.Fatalf("call %d: Token = %q, want %q", i+1, got, tt.want)
+				}
+			}
+			if got := runs(); got != tt.wantRuns {
+				t.Errorf("command ran %d times, want %d", got, tt.wantRuns)
+			}
+		})
+	}
+}
+
+func TestCommandTokenSourceErrors(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+	tests := []struct {
+		name    string
+		script  string
+		wantErr string
+	}{
+		{"stderr is included", "echo 'login required' >&2; exit 3", "login required"},
+		{"exit status without stderr", "exit 3", "exit status 3"},
+		{"no output", "true", "printed no token"},
+		{"ExecCredential without token", `echo '{"status":{}}'`, "has no status.token"},
+		{"invalid ExecCredential", `echo '{"status":'`, "decode ExecCredential"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := newCommandTokenSource([]string{"sh", "-c", tt.script})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = src.Token(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
-	kapi "github.com/kubermatic/go-kubermatic/client/project"
 	"github.com/kubermatic/go-kubermatic/models"
 )
 
@@ -18,10 +17,6 @@ func (rb *ResourceBase) ValidateResourceBase(diagnostics *resource.CreateRespons
 		diagnostics.Diagnostics.AddError("Provider not configured", "KKP client is nil")
 		return false
 	}
-	if rb.DefaultProjectID == "" {
-		diagnostics.Diagnostics.AddError("Missing provider project_id", "Set 'project_id' in the provider configuration.")
-		return false
-	}
 	return true
 }
 
@@ -31,10 +26,6 @@ func (rb *ResourceBase) ValidateResourceBaseRead(diagnostics *resource.ReadRespo
 		diagnostics.Diagnostics.AddError("Provider not configured", "KKP client is nil")
 		return false
 	}
-	if rb.DefaultProjectID == "" {
-		diagnostics.Diagnostics.AddError("Missing provider project_id", "Set 'project_id' in the provider configuration.")
-		return false
-	}
 	return true
 }
 
@@ -44,10 +35,6 @@ func (rb *ResourceBase) ValidateResourceBaseUpdate(diagnostics *resource.UpdateR
 		diagnostics.Diagnostics.AddError("Provider not configured", "KKP client is nil")
 		return false
 	}
-	if rb.DefaultProjectID == "" {
-		diagnostics.Diagnostics.AddError("Missing provider project_id", "Set 'project_id' in the provider configuration.")
-		return false
-	}
 	return true
 }
 
@@ -57,10 +44,6 @@ func (rb *ResourceBase) ValidateResourceBaseDelete(diagnostics *resource.DeleteR
 		diagnostics.Diagnostics.AddError("Provider not configured", "KKP client is nil")
 		return false
 	}
-	if rb.DefaultProjectID == "" {
-		diagnostics.Diagnostics.AddError("Missing provider project_id", "Set 'project_id' in the provider configuration.")
-		return false
-	}
 	return true
 }
 
@@ -111,10 +94,6 @@ func (dsb *DataSourceBase) ValidateDataSourceBase(diagnostics *datasource.ReadRe
 		diagnostics.Diagnostics.AddError("Provider not configured", "KKP client is nil")
 		return false
 	}
-	if dsb.DefaultProjectID == "" {
-		diagnostics.Diagnostics.AddError("Missing provider project_id", "Set 'project_id' in the provider configuration.")
-		return false
-	}
 	return true
 }
 
@@ -147,15 +126,6 @@ func (rb *ResourceBase) ConfigureResource(req resource.ConfigureRequest, resp *r
 }
 
 // FetchClusters retrieves clusters from KKP API for a given project
-func (dsb *DataSourceBase) FetchClusters() ([]*models.Cluster, error) {
-	pcli := kapi.New(dsb.Client.Transport, nil)
-	params := kapi.NewListClustersV2Params().WithProjectID(dsb.DefaultProjectID)
-	resp, err := pcli.ListClustersV2(params, nil)
-	if err != nil {
-		return nil, err
-	}
-	if resp.Payload == nil {
-		return nil, nil
-	}
-	return resp.Payload.Clusters, nil
+func (dsb *DataSourceBase) FetchClusters(ctx context.Context, projectID string) ([]*models.Cluster, error) {
+	return dsb.Client.ListClusters(ctx, projectID)
 }
@@ -0,0 +1,256 @@
+package kkp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+// ErrorKind classifies errors returned by the KKP API.
+type ErrorKind int
+
+// Error kinds
+const (
+	// ErrorKindUnknown is an error that could not be classified.
+	ErrorKindUnknown ErrorKind = iota
+	// ErrorKindNotFound means the object does not exist (404/410).
+	ErrorKindNotFound
+	// ErrorKindConflict means the object already exists or was modified concurrently (409).
+	ErrorKindConflict
+	// ErrorKindForbidden means the token is valid but lacks permission (403).
+	ErrorKindForbidden
+	// ErrorKindUnauthorized means the token is missing, invalid or expired (401).
+	ErrorKindUnauthorized
+	// ErrorKindValidation means KKP rejected the request body or parameters (400/422).
+	ErrorKindValidation
+	// ErrorKindTransient means the request may succeed when retried (408/429/5xx, network errors).
+	ErrorKindTransient
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindNotFound:
+		return "not found"
+	case ErrorKindConflict:
+		return "conflict"
+	case ErrorKindForbidden:
+		return "forbidden"
+	case ErrorKindUnauthorized:
+		return "unauthorized"
+	case ErrorKindValidation:
+		return "validation"
+	case ErrorKindTransient:
+		return "transient"
+	default:
+		return "unknown"
+	}
+}
+
+// Sentinel errors matched by APIError via errors.Is.
+var (
+	ErrNotFound     = errors.New("kkp: not found")
+	ErrConflict     = errors.New("kkp: conflict")
+	ErrForbidden    = errors.New("kkp: forbidden")
+	ErrUnauthorized = errors.New("kkp: unauthorized")
+	ErrValidation   = errors.New("kkp: validation failed")
+	ErrTransient    = errors.New("kkp: transient error")
+)
+
+// APIError is a classified KKP API error with the KKP error payload decoded.
+type APIError struct {
+	Kind       ErrorKind
+	StatusCode int      // 0 when the request never got an HTTP response
+	Message    string   // KKP error message, or the raw error text when no payload was returned
+	Details    []string // additional details from the KKP error payload
+	Err        error    // the original error
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if len(e.Details) > 0 {
+		msg += " (" + strings.Join(e.Details, "; ") + ")"
+	}
+	if e.StatusCode > 0 {
+		return fmt.Sprintf("KKP API error %d: %s", e.StatusCode, msg)
+	}
+	return msg
+}
+
+// Unwrap returns the original error.
+func (e *APIError) Unwrap() error { return e.Err }
+
+// Is matches the sentinel error of the error kind.
+func (e *APIError) Is(target error) bool {
+	switch e.Kind {
+	case ErrorKindNotFound:
+		return target == ErrNotFound
+	case ErrorKindConflict:
+		return target == ErrConflict
+	case ErrorKindForbidden:
+		return target == ErrForbidden
+	case ErrorKindUnauthorized:
+		return target == ErrUnauthorized
+	case ErrorKindValidation:
+		return target == ErrValidation
+	case ErrorKindTransient:
+		return target == ErrTransient
+	}
+	return false
+}
+
+// probedStatusCodes are checked against generated response types that only expose IsCode.
+var probedStatusCodes = []int{400, 401, 403, 404, 405, 408, 409, 410, 422, 425, 429, 500, 502, 503, 504}
+
+// TranslateError maps a generated-client error into an *APIError. Nil, context cancellation
+// and already translated errors are returned unchanged.
+func TranslateError(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return err
+	}
+
+	out := &APIError{Err: err, StatusCode: statusCodeOf(err), Message: strings.TrimSpace(err.Error())}
+	if p, ok := err.(interface{ GetPayload() *models.ErrorResponse }); ok {
+		if payload := p.GetPayload(); payload != nil && payload.Error != nil {
+			if payload.Error.Message != nil && strings.TrimSpace(*payload.Error.Message) != "" {
+				out.Message = strings.TrimSpace(*payload.Error.Message)
+			}
+			out.Details = payload.Error.Additional
+			if out.StatusCode == 0 && payload.Error.Code != nil {
+				out.StatusCode = int(*payload.Error.Code)
+			}
+		}
+	}
+
+	switch {
+	case out.StatusCode > 0:
+		out.Kind = kindForStatus(out.StatusCode)
+	case isNetworkError(err):
+		out.Kind = ErrorKindTransient
+	}
+	return out
+}
+
+// statusCodeOf extracts the HTTP status code from generated-client error types.
+func statusCodeOf(err error) int {
+	var rtErr *runtime.APIError
+	if errors.As(err, &rtErr) {
+		return rtErr.Code
+	}
+	if c, ok := err.(interface{ Code() int }); ok {
+		return c.Code()
+	}
+	if c, ok := err.(interface{ IsCode(int) bool }); ok {
+		for _, code := range probedStatusCodes {
+			if c.IsCode(code) {
+				return code
+			}
+		}
+	}
+	return 0
+}
+
+// kindForStatus maps an HTTP status code to an error kind.
+func kindForStatus(code int) ErrorKind {
+	switch {
+	case code == 400 || code == 422:
+		return ErrorKindValidation
+	case code == 401:
+		return ErrorKindUnauthorized
+	case code == 403:
+		return ErrorKindForbidden
+	case code == 404 || code == 410:
+		return ErrorKindNotFound
+	case code == 409:
+		return ErrorKindConflict
+	case code == 408 || code == 425 || code == 429 || code >= 500:
+		return ErrorKindTransient
+	}
+	return ErrorKindUnknown
+}
+
+// isNetworkError reports whether err is a connection-level failure worth retrying.
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// ErrorKindOf returns the kind of a (possibly untranslated) API error.
+func ErrorKindOf(err error) ErrorKind {
+	var apiErr *APIError
+	if errors.As(TranslateError(err), &apiErr) {
+		return apiErr.Kind
+	}
+	return ErrorKindUnknown
+}
+
+// IsNotFound reports whether err means the requested object does not exist.
+func IsNotFound(err error) bool { return ErrorKindOf(err) == ErrorKindNotFound }
+
+// IsConflict reports whether err means the object already exists or was modified concurrently.
+func IsConflict(err error) bool { return ErrorKindOf(err) == ErrorKindConflict }
+
+// IsForbidden reports whether err means the token lacks permission for the operation.
+func IsForbidden(err error) bool { return ErrorKindOf(err) == ErrorKindForbidden }
+
+// IsUnauthorized reports whether err means the token is missing, invalid or expired.
+func IsUnauthorized(err error) bool { return ErrorKindOf(err) == ErrorKindUnauthorized }
+
+// IsValidation reports whether err means KKP rejected the request as invalid.
+func IsValidation(err error) bool { return ErrorKindOf(err) == ErrorKindValidation }
+
+// IsTransient reports whether err may succeed when retried.
+func IsTransient(err error) bool { return ErrorKindOf(err) == ErrorKindTransient }
+
+// errorHint returns actionable guidance for an error kind.
+func errorHint(kind ErrorKind) string {
+	switch kind {
+	case ErrorKindNotFound:
+		return "The object does not exist in KKP. It may have been deleted outside Terraform, or the ID or project is wrong."
+	case ErrorKindConflict:
+		return "An object with the same identity already exists or was modified concurrently. Choose a different name, import the existing object, or retry."
+	case ErrorKindForbidden:
+		return "The token is valid but lacks permission for this operation. Check the service account's role in the project (e.g. Editor instead of Viewer)."
+	case ErrorKindUnauthorized:
+		return "KKP rejected the token. Check that the provider token is set, valid and not expired."
+	case ErrorKindValidation:
+		return "KKP rejected the request as invalid. Check the configured values against the message above."
+	case ErrorKindTransient:
+		return "KKP or the network is temporarily unavailable. Retry the operation."
+	}
+	return ""
+}
+
+// APIErrorDetail renders an error as diagnostic detail: the decoded KKP message followed by
+// actionable guidance for the error kind.
+func APIErrorDetail(err error) string {
+	translated := TranslateError(err)
+	var apiErr *APIError
+	if !errors.As(translated, &apiErr) {
+		return translated.Error()
+	}
+	if hint := errorHint(apiErr.Kind); hint != "" {
+		return apiErr.Error() + "\n\n" + hint
+	}
+	return apiErr.Error()
+}
+
+// AddAPIError appends an error diagnostic for a failed KKP API call.
+func AddAPIError(diags *diag.Diagnostics, summary string, err error) {
+	diags.AddError(summary, APIErrorDetail(err))
+}
+
+// AddAPIWarning appends a warning diagnostic for a failed KKP API call.
+func AddAPIWarning(diags *diag.Diagnostics, summary string, err error) {
+	diags.AddWarning(summary, APIErrorDetail(err))
+}
@@ -0,0 +1,121 @@
+package kkp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	presetapi "github.com/kubermatic/go-kubermatic/client/preset"
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+// createClusterError returns the generated default error response for a cluster create
+// that failed with code and the given KKP error message.
+func createClusterError(code int, message string) error {
+	e := kapi.NewCreateClusterV2Default(code)
+	c := int64(code)
+	e.Payload = &models.ErrorResponse{Error: &models.ErrorDetails{
+		Code:       &c,
+		Message:    &message,
+		Additional: []string{"spec.version: unsupported"},
+	}}
+	return e
+}
+
+func TestTranslateErrorClassifiesGeneratedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantKind   ErrorKind
+		wantStatus int
+		sentinel   error
+	}{
+		{"not found (IsCode only)", presetapi.NewDeletePresetNotFound(), ErrorKindNotFound, 404, ErrNotFound},
+		{"conflict (IsCode only)", kapi.NewCreateProjectConflict(), ErrorKindConflict, 409, ErrConflict},
+		{"forbidden", kapi.NewGetClusterV2Forbidden(), ErrorKindForbidden, 403, ErrForbidden},
+		{"unauthorized", kapi.NewGetClusterV2Unauthorized(), ErrorKindUnauthorized, 401, ErrUnauthorized},
+		{"default 409", createClusterError(409, "cluster exists"), ErrorKindConflict, 409, ErrConflict},
+		{"default 422", createClusterError(422, "invalid spec"), ErrorKindValidation, 422, ErrValidation},
+		{"default 400", createClusterError(400, "bad request"), ErrorKindValidation, 400, ErrValidation},
+		{"default 500", createClusterError(500, "internal error"), ErrorKindTransient, 500, ErrTransient},
+		{"default 501", createClusterError(501, "not implemented"), ErrorKindTransient, 501, ErrTransient},
+		{"runtime 404", runtime.NewAPIError("getClusterV2", nil, 404), ErrorKindNotFound, 404, ErrNotFound},
+		{"runtime 410", runtime.NewAPIError("getClusterV2", nil, 410), ErrorKindNotFound, 410, ErrNotFound},
+		{"runtime 429", runtime.NewAPIError("listClustersV2", nil, 429), ErrorKindTransient, 429, ErrTransient},
+		{"runtime 418", runtime.NewAPIError("listClustersV2", nil, 418), ErrorKindUnknown, 418, nil},
+		{"wrapped", fmt.Errorf("read cluster: %w", runtime.NewAPIError("getClusterV2", nil, 404)), ErrorKindNotFound, 404, ErrNotFound},
+		{"network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, ErrorKindTransient, 0, ErrTransient},
+		{"unexpected EOF", io.ErrUnexpectedEOF, ErrorKindTransient, 0, ErrTransient},
+		{"plain", errors.New("something else"), ErrorKindUnknown, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var apiErr *APIError
+			if !errors.As(TranslateError(tt.err), &apiErr) {
+				t.Fatalf("TranslateError(%v) did not return an *APIError", tt.err)
+			}
+			if apiErr.Kind != tt.wantKind {
+				t.Errorf("Kind = %s, want %s", apiErr.Kind, tt.wantKind)
+			}
+			if apiErr.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.wantStatus)
+			}
+			if !errors.Is(apiErr, tt.err) {
+				t.Error("translated error does not wrap the original")
+			}
+			if tt.sentinel != nil && !errors.Is(apiErr, tt.sentinel) {
+				t.Errorf("errors.Is(_, %v) = false", tt.sentinel)
+			}
+
+			if got := IsNotFound(tt.err); got != (tt.wantKind == ErrorKindNotFound) {
+				t.Errorf("IsNotFound = %v", got)
+			}
+			if got := IsConflict(tt.err); got != (tt.wantKind == ErrorKindConflict) {
+				t.Errorf("IsConflict = %v", got)
+			}
+			if got := IsForbidden(tt.err); got != (tt.wantKind == ErrorKindForbidden) {
+				t.Errorf("IsForbidden = %v", got)
+			}
+			if got := IsTransient(tt.err); got != (tt.wantKind == ErrorKindTransient) {
+				t.Errorf("IsTransient = %v", got)
+			}
+		})
+	}
+}
+
+func TestTranslateErrorDecodesPayload(t *testing.T) {
+	var apiErr *APIError
+	if !errors.As(TranslateError(createClusterError(422, " invalid spec ")), &apiErr) {
+		t.Fatal("expected an *APIError")
+	}
+	if apiErr.Message != "invalid spec" {
+		t.Errorf("Message = %q, want %q", apiErr.Message, "invalid spec")
+	}
+	if len(apiErr.Details) != 1 || apiErr.Details[0] != "spec.version: unsupported" {
+		t.Errorf("Details = %v", apiErr.Details)
+	}
+	if want := "KKP API error 422: invalid spec (spec.version: unsupported)"; apiErr.Error() != want {
+		t.Errorf("Error() = %q, want %q", apiErr.Error(), want)
+	}
+}
+
+func TestTranslateErrorPassesThrough(t *testing.T) {
+	for _, err := range []error{nil, context.Canceled, context.DeadlineExceeded, fmt.Errorf("poll: %w", context.Canceled)} {
+		if got := TranslateError(err); got != err {
+			t.Errorf("TranslateError(%v) = %v, want it unchanged", err, got)
+		}
+		if IsNotFound(err) || IsTransient(err) {
+			t.Errorf("%v classified as an API error", err)
+		}
+	}
+
+	translated := TranslateError(runtime.NewAPIError("getClusterV2", nil, 404))
+	if again := TranslateError(translated); again != translated {
+		t.Error("TranslateError is not idempotent")
+	}
+}
@@ -0,0 +1,107 @@
+package kkp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ---------- Data source filters ----------
+
+// NameFilterAttribute is the optional exact-name filter of list data sources.
+func NameFilterAttribute(kind string) dschema.StringAttribute {
+	return dschema.StringAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return %s with exactly this name.", kind),
+	}
+}
+
+// NameRegexFilterAttribute is the optional name_regex filter of list and singular data sources.
+func NameRegexFilterAttribute(kind string) dschema.StringAttribute {
+	return dschema.StringAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return %s whose name matches this regular expression (RE2 syntax).", kind),
+	}
+}
+
+// LabelsFilterAttribute is the optional labels filter of list data sources.
+func LabelsFilterAttribute(kind string) dschema.MapAttribute {
+	return dschema.MapAttribute{
+		Optional:    true,
+		ElementType: tftypes.StringType,
+		Description: fmt.Sprintf("Only return %s that carry all of these labels.", kind),
+	}
+}
+
+// Filter selects API objects by name, name regular expression and labels. The zero value
+// matches everything.
+type Filter struct {
+	Name      string
+	NameRegex *regexp.Regexp
+	Labels    map[string]string
+}
+
+// NewFilter builds a Filter from the name, name_regex and labels attributes of a data source
+// config. Pass a null labels map for data sources without a labels filter.
+func NewFilter(ctx context.Context, name, nameRegex tftypes.String, labels tftypes.Map, diags *diag.Diagnostics) Filter {
+	f := Filter{Name: TrimmedStringValue(name)}
+
+	if expr := TrimmedStringValue(nameRegex); expr != "" {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		}
+		f.NameRegex = re
+	}
+
+	if !labels.IsNull() && !labels.IsUnknown() {
+		f.Labels = map[string]string{}
+		diags.Append(labels.ElementsAs(ctx, &f.Labels, false)...)
+	}
+
+	return f
+}
+
+// Match reports whether an object with the given name and labels passes the filter.
+func (f Filter) Match(name string, labels map[string]string) bool {
+	if f.Name != "" && name != f.Name {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(name) {
+		return false
+	}
+	return HasLabels(labels, f.Labels)
+}
+
+// HasLabels reports whether labels contains every key-value pair of want.
+func HasLabels(labels, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckSingleMatch adds an error unless exactly one object matched the filters of a singular
+// data source. names identifies the matches in the error message.
+func CheckSingleMatch(diags *diag.Diagnostics, kind string, names []string) bool {
+	switch len(names) {
+	case 1:
+		return true
+	case 0:
+		diags.AddError(fmt.Sprintf("No matching %s", kind),
+			fmt.Sprintf("No %s matched the given filters.", kind))
+	default:
+		diags.AddError(fmt.Sprintf("Multiple matching %ss", kind),
+			fmt.Sprintf("%d %ss matched the given filters (%s); narrow them down so exactly one matches.",
+				len(names), kind, strings.Join(names, ", ")))
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package kkp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterMatch(t *testing.T) {
+	var diags diag.Diagnostics
+	labels := tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+		"env": tftypes.StringValue("prod"),
+	})
+	f := NewFilter(context.Background(), tftypes.StringNull(), tftypes.StringValue("^edge-[0-9]+$"), labels, &diags)
+	if diags.HasError() {
+		t.Fatalf("NewFilter: %v", diags)
+	}
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{"edge-1", map[string]string{"env": "prod", "team": "a"}, true},
+		{"edge-1", map[string]string{"env": "dev"}, false},
+		{"edge-1", nil, false},
+		{"core-1", map[string]string{"env": "prod"}, false},
+	}
+	for _, tt := range tests {
+		if got := f.Match(tt.name, tt.labels); got != tt.want {
+			t.Errorf("Match(%q, %v) = %v, want %v", tt.name, tt.labels, got, tt.want)
+		}
+	}
+
+	if !(Filter{}).Match("anything", nil) {
+		t.Error("zero Filter should match everything")
+	}
+}
+
+func TestNewFilterInvalidRegex(t *testing.T) {
+	var diags diag.Diagnostics
+	NewFilter(context.Background(), tftypes.StringNull(), tftypes.StringValue("edge-("), tftypes.MapNull(tftypes.StringType), &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error for an invalid name_regex")
+	}
+}
+
+func TestCheckSingleMatch(t *testing.T) {
+	for _, tt := range []struct {
+		names []string
+		want  bool
+	}{
+		{nil, false},
+		{[]string{"a"}, true},
+		{[]string{"a", "b"}, false},
+	} {
+		var diags diag.Diagnostics
+		if got := CheckSingleMatch(&diags, "cluster", tt.names); got != tt.want || diags.HasError() == tt.want {
+			t.Errorf("CheckSingleMatch(%v) = %v (errors: %v), want %v", tt.names, got, diags, tt.want)
+		}
+	}
+}
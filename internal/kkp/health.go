@@ -2,46 +2,233 @@ package kkp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 	"time"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	kapi "github.com/kubermatic/go-kubermatic/client/project"
 	"github.com/kubermatic/go-kubermatic/models"
 )
 
-// Accept KKP's stringy enums like "HealthStatusUp" or plain "Up".
+// statusUp reports whether a KKP health status is "up". KKP reports stringy enums
+// like "HealthStatusUp"; the plain form "Up" is accepted too, anything else is not up.
 func statusUp(s models.HealthStatus) bool {
-	v := strings.ToLower(string(s))
-	return v == "up" || strings.HasSuffix(v, "up") || v == "1" || v == "true"
+	return HealthStatusString(s) == "up"
 }
 
-// HealthReady performs lenient core readiness check for control-plane and worker-critical components.
-func HealthReady(h *models.ClusterHealth) bool {
+// DefaultRequiredComponents lists the control-plane and worker-critical components that
+// must be up for a cluster to be considered ready when no explicit list is configured.
+var DefaultRequiredComponents = []string{
+	"apiserver",
+	"controller",
+	"scheduler",
+	"etcd",
+	"machine_controller",
+	"operating_system_manager",
+}
+
+// servingComponents are the components that must be up for a cluster to serve requests.
+var servingComponents = []string{"apiserver", "etcd"}
+
+// ReadinessPolicy describes when a cluster counts as ready. The zero value requires
+// DefaultRequiredComponents to be up on a single poll.
+type ReadinessPolicy struct {
+	// RequiredComponents lists component names (see HealthComponents) that must be up.
+	// Empty means DefaultRequiredComponents.
+	RequiredComponents []string
+	// ConsecutiveSuccesses is the number of consecutive healthy polls needed before the
+	// cluster is reported ready. Values below 1 mean 1.
+	ConsecutiveSuccesses int
+	// AllowDegraded accepts a cluster that is serving (API server and etcd up) even
+	// when other required components are not up yet.
+	AllowDegraded bool
+}
+
+// requiredComponents returns the effective list of required component names.
+func (p ReadinessPolicy) requiredComponents() []string {
+	if len(p.RequiredComponents) == 0 {
+		return DefaultRequiredComponents
+	}
+	return p.RequiredComponents
+}
+
+// consecutiveSuccesses returns the effective number of consecutive healthy polls.
+func (p ReadinessPolicy) consecutiveSuccesses() int {
+	if p.ConsecutiveSuccesses < 1 {
+		return 1
+	}
+	return p.ConsecutiveSuccesses
+}
+
+// Validate checks that all required components are known health components.
+func (p ReadinessPolicy) Validate() error {
+	known := HealthComponentNames()
+	for _, name := range p.RequiredComponents {
+		found := false
+		for _, k := range known {
+			if name == k {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown health component %q (expected one of: %s)", name, strings.Join(known, ", "))
+		}
+	}
+	return nil
+}
+
+// Evaluate reports whether the health payload satisfies the policy on a single poll,
+// together with the required components that are not up.
+func (p ReadinessPolicy) Evaluate(h *models.ClusterHealth) (bool, []string) {
 	if h == nil {
-		return false
+		return false, p.requiredComponents()
+	}
+
+	statuses := make(map[string]models.HealthStatus, len(healthComponentTable))
+	for _, c := range HealthComponents(h) {
+		statuses[c.Name] = c.Status
+	}
+
+	var notUp []string
+	for _, name := range p.requiredComponents() {
+		if !statusUp(statuses[name]) {
+			notUp = append(notUp, name)
+		}
+	}
+	if len(notUp) == 0 {
+		return true, nil
 	}
-	required := []struct {
-		name   string
-		status models.HealthStatus
-	}{
-		{"apiserver", h.Apiserver},
-		{"controller", h.Controller},
-		{"scheduler", h.Scheduler},
-		{"etcd", h.Etcd},
-		{"machineController", h.MachineController},
-		{"operatingSystemManager", h.OperatingSystemManager},
+
+	if p.AllowDegraded {
+		for _, name := range servingComponents {
+			if !statusUp(statuses[name]) {
+				return false, notUp
+			}
+		}
+		return true, notUp
 	}
 
-	for _, component := range required {
-		if !statusUp(component.status) {
-			return false
+	return false, notUp
+}
+
+// Ready reports whether the health payload satisfies the policy on a single poll.
+func (p ReadinessPolicy) Ready(h *models.ClusterHealth) bool {
+	ready, _ := p.Evaluate(h)
+	return ready
+}
+
+// HealthReady performs the default core readiness check for control-plane and worker-critical components.
+func HealthReady(h *models.ClusterHealth) bool {
+	return ReadinessPolicy{}.Ready(h)
+}
+
+// HealthComponent is the reported status of a single cluster component.
+type HealthComponent struct {
+	// Name is the Terraform attribute name of the component (e.g. "machine_controller").
+	Name string
+	// Label is the human-readable component name used in descriptions and messages.
+	Label  string
+	Status models.HealthStatus
+}
+
+// healthComponentTable maps every component reported by the KKP health endpoint to its
+// attribute name and label, in a stable order.
+var healthComponentTable = []struct {
+	name  string
+	label string
+	get   func(h *models.ClusterHealth) models.HealthStatus
+}{
+	{"apiserver", "API server", func(h *models.ClusterHealth) models.HealthStatus { return h.Apiserver }},
+	{"controller", "controller manager", func(h *models.ClusterHealth) models.HealthStatus { return h.Controller }},
+	{"scheduler", "scheduler", func(h *models.ClusterHealth) models.HealthStatus { return h.Scheduler }},
+	{"etcd", "etcd", func(h *models.ClusterHealth) models.HealthStatus { return h.Etcd }},
+	{"machine_controller", "machine controller", func(h *models.ClusterHealth) models.HealthStatus { return h.MachineController }},
+	{"operating_system_manager", "operating system manager", func(h *models.ClusterHealth) models.HealthStatus { return h.OperatingSystemManager }},
+	{"cloud_provider_infrastructure", "cloud provider infrastructure", func(h *models.ClusterHealth) models.HealthStatus { return h.CloudProviderInfrastructure }},
+	{"user_cluster_controller_manager", "user cluster controller manager", func(h *models.ClusterHealth) models.HealthStatus { return h.UserClusterControllerManager }},
+	{"application_controller", "application controller", func(h *models.ClusterHealth) models.HealthStatus { return h.ApplicationController }},
+	{"gatekeeper_controller", "Gatekeeper controller", func(h *models.ClusterHealth) models.HealthStatus { return h.GatekeeperController }},
+	{"gatekeeper_audit", "Gatekeeper audit", func(h *models.ClusterHealth) models.HealthStatus { return h.GatekeeperAudit }},
+	{"monitoring", "monitoring", func(h *models.ClusterHealth) models.HealthStatus { return h.Monitoring }},
+	{"logging", "logging", func(h *models.ClusterHealth) models.HealthStatus { return h.Logging }},
+	{"alertmanager_config", "Alertmanager config", func(h *models.ClusterHealth) models.HealthStatus { return h.AlertmanagerConfig }},
+	{"mla_gateway", "MLA gateway", func(h *models.ClusterHealth) models.HealthStatus { return h.MlaGateway }},
+	{"kubernetes_dashboard", "Kubernetes dashboard", func(h *models.ClusterHealth) models.HealthStatus { return h.KubernetesDashboard }},
+	{"kubelb", "KubeLB", func(h *models.ClusterHealth) models.HealthStatus { return h.Kubelb }},
+}
+
+// HealthComponents returns the status of every component reported by the KKP health
+// endpoint in a stable order. A nil payload yields components with empty statuses.
+func HealthComponents(h *models.ClusterHealth) []HealthComponent {
+	out := make([]HealthComponent, 0, len(healthComponentTable))
+	for _, c := range healthComponentTable {
+		component := HealthComponent{Name: c.name, Label: c.label}
+		if h != nil {
+			component.Status = c.get(h)
 		}
+		out = append(out, component)
 	}
+	return out
+}
+
+// HealthComponentNames returns the names of all reported health components in a stable order.
+func HealthComponentNames() []string {
+	names := make([]string, 0, len(healthComponentTable))
+	for _, c := range healthComponentTable {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// HealthStatusString normalizes a KKP health status such as "HealthStatusUp" to "up".
+// Unreported components yield an empty string.
+func HealthStatusString(s models.HealthStatus) string {
+	v := strings.TrimSpace(string(s))
+	v = strings.TrimPrefix(v, "HealthStatus")
+	return strings.ToLower(v)
+}
+
+// HealthAttributeTypes returns the object attribute types of the per-component health attribute.
+func HealthAttributeTypes() map[string]attr.Type {
+	types := make(map[string]attr.Type, len(healthComponentTable))
+	for _, c := range healthComponentTable {
+		types[c.name] = tftypes.StringType
+	}
+	return types
+}
+
+// HealthAttributeDescriptions returns the description of each per-component health attribute.
+func HealthAttributeDescriptions() map[string]string {
+	descriptions := make(map[string]string, len(healthComponentTable))
+	for _, c := range healthComponentTable {
+		descriptions[c.name] = strings.ToUpper(c.label[:1]) + c.label[1:] + " status (up, down or provisioning). Null when the component is not reported."
+	}
+	return descriptions
+}
 
-	return true
+// HealthObjectValue converts a health payload to the per-component health attribute value.
+// A nil payload yields a null object.
+func HealthObjectValue(h *models.ClusterHealth) (tftypes.Object, diag.Diagnostics) {
+	if h == nil {
+		return tftypes.ObjectNull(HealthAttributeTypes()), nil
+	}
+	values := make(map[string]attr.Value, len(healthComponentTable))
+	for _, c := range HealthComponents(h) {
+		if s := HealthStatusString(c.Status); s != "" {
+			values[c.Name] = tftypes.StringValue(s)
+		} else {
+			values[c.Name] = tftypes.StringNull()
+		}
+	}
+	return tftypes.ObjectValue(HealthAttributeTypes(), values)
 }
 
 // StatusURLReady returns (url, true) if a non-empty URL field is present.
@@ -80,13 +267,22 @@ func StatusURLReady(status any) (string, bool) {
 
 // Poll calls fn immediately and then every `interval` until fn returns done=true,
 // an error, or the context is canceled/expired.
-// It returns fn's error (if any) or ctx.Err() when the context ends.
+// It returns fn's error (if any) or ctx.Err() when the context ends. fn receives ctx and
+// is expected to pass it to API calls so in-flight requests abort on cancellation; Poll
+// itself never starts another attempt once ctx is done.
 func Poll(ctx context.Context, interval time.Duration, fn func(context.Context) (done bool, err error)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// First attempt (no initial delay)
 	done, err := fn(ctx)
 	if done || err != nil {
 		return err
 	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 
 	timer := time.NewTimer(interval)
 	defer timer.Stop()
@@ -96,10 +292,17 @@ func Poll(ctx context.Context, interval time.Duration, fn func(context.Context)
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-timer.C:
+			if err := ctx.Err(); err != nil {
+				return err
+			}
 			done, err = fn(ctx)
 			if done || err != nil {
 				return err
 			}
+			// An attempt interrupted by cancellation returns immediately instead of waiting a full interval
+			if err := ctx.Err(); err != nil {
+				return err
+			}
 			// Reset timer safely
 			if !timer.Stop() {
 				select {
@@ -130,18 +333,19 @@ func (c *ClusterHealthChecker) WaitForClusterReady(ctx context.Context) error {
 
 // WaitForClusterReadyWithTimeout waits for cluster to become healthy with custom timeout
 func (c *ClusterHealthChecker) WaitForClusterReadyWithTimeout(ctx context.Context, interval, timeout time.Duration) error {
-	return PollWithTimeout(ctx, interval, timeout, func(pc context.Context) (bool, error) {
-		pcli := kapi.New(c.Client.Transport, nil)
+	healthyPolls := 0
+	since := time.Now().Add(-eventClockSkew)
+	var lastHealth *models.ClusterHealth
+	var lastErr error
+
+	err := PollWithTimeout(ctx, interval, timeout, func(pc context.Context) (bool, error) {
+		pcli := c.Client.Project
 
 		// Check health first (authoritative for readiness)
-		hres, herr := pcli.GetClusterHealthV2(
-			kapi.NewGetClusterHealthV2Params().
-				WithProjectID(c.ProjectID).
-				WithClusterID(c.ClusterID),
-			nil,
-		)
-		if herr == nil && hres != nil && hres.Payload != nil {
-			h := hres.Payload
+		h, herr := c.FetchHealth(pc)
+		if herr == nil && h != nil {
+			lastHealth = h
+			lastErr = nil
 			tflog.Debug(pc, "cluster health check", map[string]any{
 				"cluster_id": c.ClusterID,
 				"apiserver":  string(h.Apiserver),
@@ -149,20 +353,50 @@ func (c *ClusterHealthChecker) WaitForClusterReadyWithTimeout(ctx context.Contex
 				"scheduler":  string(h.Scheduler),
 				"etcd":       string(h.Etcd),
 			})
-			if HealthReady(h) {
-				tflog.Info(pc, "cluster is healthy", map[string]any{"cluster_id": c.ClusterID})
-				return true, nil
+			ready, notUp := c.Readiness.Evaluate(h)
+			if ready {
+				healthyPolls++
+				if healthyPolls >= c.Readiness.consecutiveSuccesses() {
+					tflog.Info(pc, "cluster is healthy", map[string]any{
+						"cluster_id":    c.ClusterID,
+						"degraded":      notUp,
+						"healthy_polls": healthyPolls,
+					})
+					return true, nil
+				}
+				tflog.Debug(pc, "cluster healthy, waiting for consecutive healthy polls", map[string]any{
+					"cluster_id":    c.ClusterID,
+					"healthy_polls": healthyPolls,
+					"required":      c.Readiness.consecutiveSuccesses(),
+				})
+				return false, nil
 			}
+			healthyPolls = 0
+			tflog.Debug(pc, "cluster not ready yet", map[string]any{
+				"cluster_id": c.ClusterID,
+				"not_up":     notUp,
+			})
 		} else if herr != nil {
+			// Credentials and permissions do not fix themselves while polling
+			if IsUnauthorized(herr) || IsForbidden(herr) {
+				return false, TranslateError(herr)
+			}
+			healthyPolls = 0
+			lastErr = herr
 			tflog.Debug(pc, "health check error", map[string]any{
 				"cluster_id": c.ClusterID,
 				"error":      herr.Error(),
 			})
 		}
 
+		// Abort early when KKP already reported a failure that waiting cannot fix
+		if terr := c.checkTerminalEvents(pc, pcli, since); terr != nil {
+			return false, terr
+		}
+
 		// Optional: get cluster status for breadcrumbs
 		if g, gerr := pcli.GetClusterV2(
-			kapi.NewGetClusterV2Params().
+			kapi.NewGetClusterV2Params().WithContext(pc).
 				WithProjectID(c.ProjectID).
 				WithClusterID(c.ClusterID),
 			nil,
@@ -173,10 +407,175 @@ func (c *ClusterHealthChecker) WaitForClusterReadyWithTimeout(ctx context.Contex
 					"api_url":    u,
 				})
 			}
+		} else if gerr != nil {
+			lastErr = gerr
 		}
 
 		return false, nil
 	})
+	if err != nil && (errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)) {
+		return fmt.Errorf("%w; %s", err, c.describeLastObserved(lastHealth, lastErr))
+	}
+	return err
+}
+
+// eventClockSkew widens the event window to tolerate clock drift between the provider and KKP.
+const eventClockSkew = time.Minute
+
+// KKP's event API (models.Event) exposes only the type, message and involved object of an
+// event; the Kubernetes Reason is dropped. Terminal failures are therefore recognised from the
+// message of warning events, structured error codes first and free-text phrases second.
+
+// terminalErrorCodes are cloud provider API error codes that KKP and machine-controller copy
+// verbatim into event messages. They are stable identifiers, so they are matched as whole
+// words and case-sensitively.
+var terminalErrorCodes = map[string]bool{
+	"InvalidClientTokenId":             true,
+	"AuthFailure":                      true,
+	"SignatureDoesNotMatch":            true,
+	"InvalidAuthenticationTokenTenant": true,
+	"QuotaExceeded":                    true,
+	"LimitExceeded":                    true,
+	"VcpuLimitExceeded":                true,
+	"InstanceLimitExceeded":            true,
+}
+
+// terminalEventPatterns are lowercase fragments of warning event messages that indicate the
+// cluster cannot be provisioned without user intervention (e.g. bad credentials, exhausted quota),
+// for providers that report no error code. Substring matching on free text is brittle: a
+// rewording in a provider SDK or KKP release silently turns a terminal failure back into a wait
+// until the timeout, and a loose fragment can flag an unrelated message. Keep fragments
+// specific, and prefer adding an entry to terminalErrorCodes where the provider has one.
+var terminalEventPatterns = []string{
+	"invalid credentials",
+	"invalid client credentials",
+	"authentication failed",
+	"failed to authenticate",
+	"the request you have made requires authentication",
+	"cannot complete login due to an incorrect user name or password",
+	"quota exceeded",
+	"exceeded quota",
+	"quota exceed",
+	"insufficient quota",
+}
+
+// ClusterTerminalError reports a failure KKP flagged for the cluster that will not resolve by waiting.
+type ClusterTerminalError struct {
+	ClusterID string
+	Reason    string
+	Message   string
+}
+
+func (e *ClusterTerminalError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("cluster %s failed (%s): %s", e.ClusterID, e.Reason, e.Message)
+	}
+	return fmt.Sprintf("cluster %s failed: %s", e.ClusterID, e.Message)
+}
+
+// IsTerminalEvent reports whether a KKP event indicates a terminal failure. Only warning
+// events are considered.
+func IsTerminalEvent(event *models.Event) bool {
+	if event == nil || !strings.EqualFold(event.Type, "warning") {
+		return false
+	}
+	return IsTerminalEventMessage(event.Message)
+}
+
+// IsTerminalEventMessage reports whether a warning event message indicates a terminal failure.
+func IsTerminalEventMessage(message string) bool {
+	words := strings.FieldsFunc(message, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if terminalErrorCodes[word] {
+			return true
+		}
+	}
+	low := strings.ToLower(message)
+	for _, pattern := range terminalEventPatterns {
+		if strings.Contains(low, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTerminalEvents returns a *ClusterTerminalError when KKP reported a warning event since
+// `since` that indicates provisioning cannot succeed. The V2 cluster status carries no conditions,
+// so cluster events are the source of truth. Errors listing events are logged and ignored.
+func (c *ClusterHealthChecker) checkTerminalEvents(ctx context.Context, pcli ClusterAPI, since time.Time) error {
+	warning := "warning"
+	res, err := pcli.GetClusterEventsV2(
+		kapi.NewGetClusterEventsV2Params().WithContext(ctx).
+			WithProjectID(c.ProjectID).
+			WithClusterID(c.ClusterID).
+			WithType(&warning),
+		nil,
+	)
+	if err != nil {
+		tflog.Debug(ctx, "list cluster events failed", map[string]any{
+			"cluster_id": c.ClusterID,
+			"error":      err.Error(),
+		})
+		return nil
+	}
+	if res == nil {
+		return nil
+	}
+
+	for _, event := range res.Payload {
+		if !IsTerminalEvent(event) {
+			continue
+		}
+		seen := time.Time(event.LastTimestamp)
+		if seen.IsZero() {
+			seen = time.Time(event.CreationTimestamp)
+		}
+		if !seen.IsZero() && seen.Before(since) {
+			continue
+		}
+		tflog.Warn(ctx, "terminal cluster event detected", map[string]any{
+			"cluster_id": c.ClusterID,
+			"event":      event.Name,
+			"message":    event.Message,
+		})
+		reason := ""
+		if event.InvolvedObject != nil {
+			reason = strings.TrimSpace(event.InvolvedObject.Type + " " + event.InvolvedObject.Name)
+		}
+		return &ClusterTerminalError{
+			ClusterID: c.ClusterID,
+			Reason:    reason,
+			Message:   strings.TrimSpace(event.Message),
+		}
+	}
+	return nil
+}
+
+// describeLastObserved summarizes the last observed component statuses for timeout errors.
+func (c *ClusterHealthChecker) describeLastObserved(h *models.ClusterHealth, lastErr error) string {
+	if h == nil {
+		if lastErr != nil {
+			return "cluster health was never reported (last error: " + lastErr.Error() + ")"
+		}
+		return "cluster health was never reported"
+	}
+
+	parts := make([]string, 0, len(healthComponentTable))
+	for _, component := range HealthComponents(h) {
+		if status := HealthStatusString(component.Status); status != "" {
+			parts = append(parts, component.Name+"="+status)
+		}
+	}
+	summary := "last observed health: " + strings.Join(parts, " ")
+	if _, notUp := c.Readiness.Evaluate(h); len(notUp) > 0 {
+		summary += " (not up: " + strings.Join(notUp, ", ") + ")"
+	}
+	if lastErr != nil {
+		summary += " (last error: " + lastErr.Error() + ")"
+	}
+	return summary
 }
 
 // WaitForClusterUpdated waits for cluster update to complete with spec verification
@@ -191,9 +590,13 @@ func (c *ClusterHealthChecker) WaitForClusterUpdatedWithTimeout(ctx context.Cont
 		seenTransition: false,
 	}
 
-	return PollWithTimeout(ctx, interval, timeout, func(pc context.Context) (bool, error) {
+	err := PollWithTimeout(ctx, interval, timeout, func(pc context.Context) (bool, error) {
 		return c.checkClusterUpdateProgress(pc, expectedSpec, state)
 	})
+	if err != nil && state.lastNote != "" && (errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)) {
+		return fmt.Errorf("%w; last observed: %s", err, state.lastNote)
+	}
+	return err
 }
 
 // WaitForClusterDeleted waits for cluster to be completely deleted
@@ -204,17 +607,16 @@ func (c *ClusterHealthChecker) WaitForClusterDeleted(ctx context.Context) error
 // WaitForClusterDeletedWithTimeout waits for cluster deletion with custom timeout
 func (c *ClusterHealthChecker) WaitForClusterDeletedWithTimeout(ctx context.Context, interval, timeout time.Duration) error {
 	return PollWithTimeout(ctx, interval, timeout, func(pc context.Context) (bool, error) {
-		pcli := kapi.New(c.Client.Transport, nil)
+		pcli := c.Client.Project
 
 		g, gerr := pcli.GetClusterV2(
-			kapi.NewGetClusterV2Params().
+			kapi.NewGetClusterV2Params().WithContext(pc).
 				WithProjectID(c.ProjectID).
 				WithClusterID(c.ClusterID),
 			nil,
 		)
 		if gerr != nil {
-			low := strings.ToLower(gerr.Error())
-			if strings.Contains(low, "404") || strings.Contains(low, "not found") {
+			if IsNotFound(gerr) {
 				tflog.Info(pc, "cluster deletion confirmed", map[string]any{"cluster_id": c.ClusterID})
 				return true, nil
 			}
@@ -238,13 +640,7 @@ func (c *ClusterHealthChecker) WaitForClusterDeletedWithTimeout(ctx context.Cont
 		}
 
 		// Optional: check health for deletion progress (often 404s before cluster is gone)
-		if hres, herr := pcli.GetClusterHealthV2(
-			kapi.NewGetClusterHealthV2Params().
-				WithProjectID(c.ProjectID).
-				WithClusterID(c.ClusterID),
-			nil,
-		); herr == nil && hres != nil && hres.Payload != nil {
-			h := hres.Payload
+		if h, herr := c.FetchHealth(pc); herr == nil && h != nil {
 			tflog.Debug(pc, "deleting cluster health", map[string]any{
 				"cluster_id": c.ClusterID,
 				"apiserver":  string(h.Apiserver),
@@ -259,7 +655,7 @@ func (c *ClusterHealthChecker) WaitForClusterDeletedWithTimeout(ctx context.Cont
 				"scheduler":  string(h.Scheduler),
 				"etcd":       string(h.Etcd),
 			})
-		} else if herr != nil && strings.Contains(strings.ToLower(herr.Error()), "404") {
+		} else if IsNotFound(herr) {
 			tflog.Debug(pc, "cluster health 404 during deletion (control-plane likely gone)", map[string]any{"cluster_id": c.ClusterID})
 		}
 
@@ -290,20 +686,14 @@ func (c *MachineDeploymentHealthChecker) WaitForMachineDeploymentReadyWithTimeou
 
 // fetchMachineDeploymentFromList retrieves the target machine deployment from the list API.
 func (c *MachineDeploymentHealthChecker) fetchMachineDeploymentFromList(ctx context.Context) (*models.NodeDeployment, error) {
-	pcli := kapi.New(c.Client.Transport, nil)
-
-	// Use ListMachineDeployments instead of GetMachineDeployment to avoid TextConsumer issues
-	listResp, listErr := pcli.ListMachineDeployments(
-		kapi.NewListMachineDeploymentsParams().
-			WithProjectID(c.ProjectID).
-			WithClusterID(c.ClusterID),
-		nil,
-	)
+	// Use ListMachineDeployments instead of GetMachineDeployment to avoid TextConsumer issues;
+	// the list is shared with other pollers of the same cluster.
+	deployments, listErr := c.Client.ListMachineDeployments(ctx, c.ProjectID, c.ClusterID)
 	if listErr != nil {
 		return nil, listErr
 	}
 
-	if listResp == nil || listResp.Payload == nil {
+	if deployments == nil {
 		tflog.Debug(ctx, "machine deployment list response not available", map[string]any{
 			"cluster_id":            c.ClusterID,
 			"machine_deployment_id": c.MachineDeploymentID,
@@ -311,7 +701,7 @@ func (c *MachineDeploymentHealthChecker) fetchMachineDeploymentFromList(ctx cont
 		return nil, nil
 	}
 
-	return c.findTargetDeploymentInList(ctx, listResp.Payload)
+	return c.findTargetDeploymentInList(ctx, deployments)
 }
 
 // handleFetchError handles errors from fetching machine deployment list.
@@ -452,18 +842,10 @@ func (c *MachineDeploymentHealthChecker) WaitForMachineDeploymentDeleted(ctx con
 // WaitForMachineDeploymentDeletedWithTimeout waits for machine deployment deletion with custom timeout
 func (c *MachineDeploymentHealthChecker) WaitForMachineDeploymentDeletedWithTimeout(ctx context.Context, interval, timeout time.Duration) error {
 	return PollWithTimeout(ctx, interval, timeout, func(pc context.Context) (bool, error) {
-		pcli := kapi.New(c.Client.Transport, nil)
-
 		// Use ListMachineDeployments instead of GetMachineDeployment to avoid TextConsumer issues
-		listResp, listErr := pcli.ListMachineDeployments(
-			kapi.NewListMachineDeploymentsParams().
-				WithProjectID(c.ProjectID).
-				WithClusterID(c.ClusterID),
-			nil,
-		)
+		deployments, listErr := c.Client.ListMachineDeployments(pc, c.ProjectID, c.ClusterID)
 		if listErr != nil {
-			low := strings.ToLower(listErr.Error())
-			if strings.Contains(low, "404") || strings.Contains(low, "not found") {
+			if IsNotFound(listErr) {
 				tflog.Info(pc, "cluster not found during machine deployment deletion check - cluster likely deleted", map[string]any{
 					"cluster_id":            c.ClusterID,
 					"machine_deployment_id": c.MachineDeploymentID,
@@ -479,7 +861,7 @@ func (c *MachineDeploymentHealthChecker) WaitForMachineDeploymentDeletedWithTime
 			return false, nil
 		}
 
-		if listResp == nil || listResp.Payload == nil {
+		if deployments == nil {
 			tflog.Debug(pc, "machine deployment list response not available during deletion", map[string]any{
 				"cluster_id":            c.ClusterID,
 				"machine_deployment_id": c.MachineDeploymentID,
@@ -488,7 +870,7 @@ func (c *MachineDeploymentHealthChecker) WaitForMachineDeploymentDeletedWithTime
 		}
 
 		// Check if our specific machine deployment is in the list
-		for _, deployment := range listResp.Payload {
+		for _, deployment := range deployments {
 			if deployment != nil && deployment.ID == c.MachineDeploymentID {
 				tflog.Debug(pc, "machine deployment still exists", map[string]any{
 					"cluster_id":            c.ClusterID,
@@ -511,6 +893,7 @@ func (c *MachineDeploymentHealthChecker) WaitForMachineDeploymentDeletedWithTime
 type clusterUpdateState struct {
 	lastNote       string
 	seenTransition bool
+	healthyPolls   int
 }
 
 // checkClusterUpdateProgress checks the progress of a cluster update operation.
@@ -542,9 +925,9 @@ func (c *ClusterHealthChecker) checkClusterUpdateProgress(ctx context.Context, e
 
 // getClusterForUpdate fetches the cluster information for update monitoring.
 func (c *ClusterHealthChecker) getClusterForUpdate(ctx context.Context) (*models.Cluster, error) {
-	pcli := kapi.New(c.Client.Transport, nil)
+	pcli := c.Client.Project
 	g, gerr := pcli.GetClusterV2(
-		kapi.NewGetClusterV2Params().
+		kapi.NewGetClusterV2Params().WithContext(ctx).
 			WithProjectID(c.ProjectID).
 			WithClusterID(c.ClusterID),
 		nil,
@@ -585,7 +968,7 @@ func (c *ClusterHealthChecker) isClusterSpecUpToDate(spec *models.ClusterSpec, e
 // checkClusterHealthAndTransitions monitors cluster health and API server transitions during update.
 func (c *ClusterHealthChecker) checkClusterHealthAndTransitions(ctx context.Context, cluster *models.Cluster, expectedSpec ClusterUpdateSpec, state *clusterUpdateState) (bool, error) {
 	// Get cluster health
-	health, err := c.getClusterHealth(ctx)
+	health, err := c.FetchHealth(ctx)
 	if err != nil {
 		return c.handleHealthCheckError(ctx, cluster, err, state)
 	}
@@ -602,26 +985,14 @@ func (c *ClusterHealthChecker) checkClusterHealthAndTransitions(ctx context.Cont
 	return c.evaluateUpdateCompletion(ctx, cluster, health, expectedSpec, state)
 }
 
-// getClusterHealth fetches the cluster health information.
-func (c *ClusterHealthChecker) getClusterHealth(_ context.Context) (*models.ClusterHealth, error) {
-	pcli := kapi.New(c.Client.Transport, nil)
-	hres, herr := pcli.GetClusterHealthV2(
-		kapi.NewGetClusterHealthV2Params().
-			WithProjectID(c.ProjectID).
-			WithClusterID(c.ClusterID),
-		nil,
-	)
-	if herr != nil {
-		return nil, herr
-	}
-	if hres == nil || hres.Payload == nil {
-		return nil, nil
-	}
-	return hres.Payload, nil
+// FetchHealth fetches the current cluster health information.
+func (c *ClusterHealthChecker) FetchHealth(ctx context.Context) (*models.ClusterHealth, error) {
+	return c.Client.GetClusterHealth(ctx, c.ProjectID, c.ClusterID)
 }
 
 // handleHealthCheckError handles health check errors during update monitoring.
 func (c *ClusterHealthChecker) handleHealthCheckError(ctx context.Context, cluster *models.Cluster, err error, state *clusterUpdateState) (bool, error) {
+	state.healthyPolls = 0
 	// Health check failures during update might indicate transition
 	if !state.seenTransition {
 		state.seenTransition = true
@@ -650,6 +1021,7 @@ func (c *ClusterHealthChecker) handleHealthCheckError(ctx context.Context, clust
 func (c *ClusterHealthChecker) checkAPIServerTransition(ctx context.Context, health *models.ClusterHealth, state *clusterUpdateState) bool {
 	apiServerDown := !statusUp(health.Apiserver)
 	if apiServerDown {
+		state.healthyPolls = 0
 		state.seenTransition = true
 		tflog.Info(ctx, "detected API server transition during update", map[string]any{
 			"cluster_id": c.ClusterID,
@@ -672,7 +1044,7 @@ func (c *ClusterHealthChecker) evaluateUpdateCompletion(ctx context.Context, clu
 		"seen_transition": state.seenTransition,
 	})
 
-	if HealthReady(health) {
+	if c.Readiness.Ready(health) {
 		// For K8s version updates, we should see the API server go down and come back up
 		// If we haven't seen a transition yet for K8s updates, keep waiting a bit longer
 		if expectedSpec.K8sVersion != "" && !state.seenTransition {
@@ -684,6 +1056,12 @@ func (c *ClusterHealthChecker) evaluateUpdateCompletion(ctx context.Context, clu
 			return false, nil
 		}
 
+		state.healthyPolls++
+		if state.healthyPolls < c.Readiness.consecutiveSuccesses() {
+			state.lastNote = fmt.Sprintf("healthy for %d of %d consecutive polls", state.healthyPolls, c.Readiness.consecutiveSuccesses())
+			return false, nil
+		}
+
 		tflog.Info(ctx, "cluster update complete", map[string]any{
 			"cluster_id":      c.ClusterID,
 			"version":         cluster.Spec.Version,
@@ -694,6 +1072,7 @@ func (c *ClusterHealthChecker) evaluateUpdateCompletion(ctx context.Context, clu
 		return true, nil
 	}
 
+	state.healthyPolls = 0
 	state.lastNote = fmt.Sprintf("spec updated but health: apiserver=%s controller=%s scheduler=%s etcd=%s",
 		health.Apiserver, health.Controller, health.Scheduler, health.Etcd)
 
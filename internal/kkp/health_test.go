@@ -0,0 +1,106 @@
+package kkp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+func TestWaitForClusterReadyAbortsInFlightRequestOnCancel(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		// Hang until the client gives up (or the test ends).
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	client, err := NewHTTPClient(Config{Endpoint: srv.URL, Timeout: time.Minute})
+	if err != nil {
+		t.Fatalf("NewHTTPClient: %v", err)
+	}
+	checker := &ClusterHealthChecker{Client: client, ProjectID: "project", ClusterID: "cluster"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- checker.WaitForClusterReadyWithTimeout(ctx, time.Hour, time.Hour)
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("health request never reached the server")
+	}
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("poll did not abort the in-flight request after cancellation")
+	}
+}
+
+func TestPollDoesNotStartAttemptAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := Poll(ctx, time.Millisecond, func(context.Context) (bool, error) {
+		calls++
+		return false, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no attempts after cancellation, got %d", calls)
+	}
+}
+
+func TestIsTerminalEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		message   string
+		want      bool
+	}{
+		{"aws error code", "Warning", "failed to create security group: InvalidClientTokenId: The security token is invalid", true},
+		{"azure error code", "Warning", "compute.VirtualMachinesClient: Code=\"QuotaExceeded\" Message=...", true},
+		{"error code needs a whole word", "Warning", "NotAuthFailureRelated happened", false},
+		{"openstack phrase", "Warning", "Quota exceeded for cores: Requested 8, but already used 60 of 64 cores", true},
+		{"phrase is case-insensitive", "warning", "Authentication failed for user admin", true},
+		{"normal event", "Normal", "InvalidClientTokenId", false},
+		{"transient warning", "Warning", "failed to reconcile: connection refused", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := &models.Event{Type: tt.eventType, Message: tt.message}
+			if got := IsTerminalEvent(event); got != tt.want {
+				t.Errorf("IsTerminalEvent(%q, %q) = %v, want %v", tt.eventType, tt.message, got, tt.want)
+			}
+		})
+	}
+	if IsTerminalEvent(nil) {
+		t.Error("IsTerminalEvent(nil) = true")
+	}
+}
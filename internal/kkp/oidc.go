@@ -0,0 +1,194 @@
+package kkp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OIDCConfig configures obtaining KKP API tokens from an OIDC provider such as Dex or Keycloak.
+type OIDCConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string   // optional for public clients
+	RefreshToken string   // selects the refresh_token grant; otherwise client_credentials is used
+	Scopes       []string // default "openid"
+}
+
+// oidcTokenSource obtains tokens from the issuer's token endpoint and caches them in memory
+// until shortly before they expire. Rotated refresh tokens are kept in memory only.
+type oidcTokenSource struct {
+	cfg        OIDCConfig
+	httpClient *http.Client
+
+	mu           sync.Mutex
+	tokenURL     string
+	token        string
+	expiry       time.Time
+	refreshToken string
+}
+
+func newOIDCTokenSource(cfg OIDCConfig, httpClient *http.Client) (*oidcTokenSource, error) {
+	if strings.TrimSpace(cfg.IssuerURL) == "" {
+		return nil, errors.New("oidc: issuer URL is required")
+	}
+	if strings.TrimSpace(cfg.ClientID) == "" {
+		return nil, errors.New("oidc: client ID is required")
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid"}
+	}
+	return &oidcTokenSource{cfg: cfg, httpClient: httpClient, refreshToken: cfg.RefreshToken}, nil
+}
+
+type oidcTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Token implements TokenSource. Concurrent callers share a single refresh.
+func (s *oidcTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Until(s.expiry) > tokenExpiryDelta {
+		return s.token, nil
+	}
+
+	if s.tokenURL == "" {
+		tokenURL, err := s.discoverTokenURL(ctx)
+		if err != nil {
+			return "", err
+		}
+		s.tokenURL = tokenURL
+	}
+
+	form := url.Values{}
+	if s.refreshToken != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", s.refreshToken)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+	form.Set("scope", strings.Join(s.cfg.Scopes, " "))
+	if s.cfg.ClientSecret == "" {
+		form.Set("client_id", s.cfg.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if s.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(s.cfg.ClientID), url.QueryEscape(s.cfg.ClientSecret))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("oidc: token request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("oidc: read token response: %w", err)
+	}
+
+	var tr oidcTokenResponse
+	_ = json.Unmarshal(body, &tr)
+	if resp.StatusCode != http.StatusOK || tr.Error != "" {
+		msg := strings.TrimSpace(tr.Error + " " + tr.ErrorDescription)
+		if msg == "" {
+			msg = strings.TrimSpace(string(body))
+		}
+		return "", fmt.Errorf("oidc: token request failed with %s: %s", resp.Status, msg)
+	}
+
+	// KKP validates OIDC ID tokens; fall back to the access token for grants that return none.
+	token := tr.IDToken
+	if token == "" {
+		token = tr.AccessToken
+	}
+	if token == "" {
+		return "", errors.New("oidc: token response contained neither id_token nor access_token")
+	}
+
+	// Prefer the exp claim of the token actually returned: expires_in describes the access
+	// token, whose lifetime can differ from the id_token's.
+	now := time.Now()
+	if exp, ok := jwtExpiry(token); ok {
+		s.expiry = exp
+	} else if tr.ExpiresIn > 0 {
+		s.expiry = now.Add(time.Duration(tr.ExpiresIn) * time.Second)
+	} else {
+		s.expiry = now.Add(defaultTokenLifetime)
+	}
+	s.token = token
+	if tr.RefreshToken != "" {
+		s.refreshToken = tr.RefreshToken
+	}
+	return s.token, nil
+}
+
+// discoverTokenURL reads the token endpoint from the issuer's discovery document.
+func (s *oidcTokenSource) discoverTokenURL(ctx context.Context) (string, error) {
+	discoveryURL := strings.TrimRight(s.cfg.IssuerURL, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("oidc: discovery: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return "", fmt.Errorf("oidc: discovery at %s returned %s", discoveryURL, resp.Status)
+	}
+
+	var doc struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&doc); err != nil {
+		return "", fmt.Errorf("oidc: decode discovery document: %w", err)
+	}
+	if doc.TokenEndpoint == "" {
+		return "", fmt.Errorf("oidc: discovery document at %s has no token_endpoint", discoveryURL)
+	}
+	return doc.TokenEndpoint, nil
+}
+
+// jwtExpiry returns the exp claim of a JWT without verifying it.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
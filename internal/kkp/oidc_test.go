@@ -0,0 +1,177 @@
+package kkp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testJWT returns an unsigned JWT with the given subject and expiry.
+func testJWT(sub string, exp time.Time) string {
+	enc := base64.RawURLEncoding
+	claims, _ := json.Marshal(map[string]any{"sub": sub, "exp": exp.Unix()})
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString(claims) + ".sig"
+}
+
+// testIssuer is an OIDC issuer whose token endpoint answers with the responses in turn and
+// records the forms it received.
+type testIssuer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses []map[string]any
+	forms     []map[string]string
+}
+
+func newTestIssuer(t *testing.T, responses ...map[string]any) *testIssuer {
+	t.Helper()
+	iss := &testIssuer{responses: responses}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"token_endpoint": iss.URL + "/token"})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		iss.mu.Lock()
+		defer iss.mu.Unlock()
+		form := map[string]string{}
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+		iss.forms = append(iss.forms, form)
+		if len(iss.responses) == 0 {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		resp := iss.responses[0]
+		iss.responses = iss.responses[1:]
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+	return iss
+}
+
+func (iss *testIssuer) tokenRequests() []map[string]string {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	return append([]map[string]string(nil), iss.forms...)
+}
+
+func TestOIDCTokenSourceChoosesToken(t *testing.T) {
+	idToken := testJWT("id", time.Now().Add(time.Hour))
+	accessToken := testJWT("access", time.Now().Add(time.Hour))
+	tests := []struct {
+		name     string
+		response map[string]any
+		want     string
+	}{
+		{"prefers id_token", map[string]any{"id_token": idToken, "access_token": accessToken}, idToken},
+		{"falls back to access_token", map[string]any{"access_token": accessToken}, accessToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss := newTestIssuer(t, tt.response)
+			src, err := newOIDCTokenSource(OIDCConfig{IssuerURL: iss.URL, ClientID: "terraform", ClientSecret: "s"}, iss.Client())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := src.Token(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Token = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCTokenSourceExpiry(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    func(i int) map[string]any
+		wantFetches int
+	}{
+		{
+			name: "cached until the id_token expires",
+			response: func(i int) map[string]any {
+				return map[string]any{"id_token": testJWT(fmt.Sprint(i), time.Now().Add(time.Hour)), "expires_in": 60}
+			},
+			wantFetches: 1,
+		},
+		{
+			name: "id_token near expiry is refreshed despite a long expires_in",
+			response: func(i int) map[string]any {
+				return map[string]any{"id_token": testJWT(fmt.Sprint(i), time.Now().Add(30*time.Second)), "expires_in": 3600}
+			},
+			wantFetches: 3,
+		},
+		{
+			name: "expires_in used for opaque tokens",
+			response: func(i int) map[string]any {
+				return map[string]any{"access_token": fmt.Sprintf("opaque-%d", i), "expires_in": 30}
+			},
+			wantFetches: 3,
+		},
+		{
+			name: "default lifetime for opaque tokens without expires_in",
+			response: func(i int) map[string]any {
+				return map[string]any{"access_token": fmt.Sprintf("opaque-%d", i)}
+			},
+			wantFetches: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss := newTestIssuer(t, tt.response(1), tt.response(2), tt.response(3))
+			src, err := newOIDCTokenSource(OIDCConfig{IssuerURL: iss.URL, ClientID: "terraform", ClientSecret: "s"}, iss.Client())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < 3; i++ {
+				if _, err := src.Token(context.Background()); err != nil {
+					t.Fatalf("call %d: %v", i+1, err)
+				}
+			}
+			if got := len(iss.tokenRequests()); got != tt.wantFetches {
+				t.Errorf("token requests = %d, want %d", got, tt.wantFetches)
+			}
+		})
+	}
+}
+
+func TestOIDCTokenSourceRotatesRefreshToken(t *testing.T) {
+	iss := newTestIssuer(t,
+		map[string]any{"id_token": testJWT("1", time.Now().Add(30*time.Second)), "refresh_token": "rotated"},
+		map[string]any{"id_token": testJWT("2", time.Now().Add(time.Hour))},
+	)
+	src, err := newOIDCTokenSource(OIDCConfig{IssuerURL: iss.URL, ClientID: "terraform", RefreshToken: "initial"}, iss.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := src.Token(context.Background()); err != nil {
+			t.Fatalf("call %d: %v", i+1, err)
+		}
+	}
+
+	forms := iss.tokenRequests()
+	if len(forms) != 2 {
+		t.Fatalf("token requests = %d, want 2", len(forms))
+	}
+	for i, want := range []string{"initial", "rotated"} {
+		if forms[i]["grant_type"] != "refresh_token" || forms[i]["refresh_token"] != want {
+			t.Errorf("request %d: form = %v, want refresh_token grant with %q", i+1, forms[i], want)
+		}
+		if forms[i]["client_id"] != "terraform" {
+			t.Errorf("request %d: public client did not send client_id", i+1)
+		}
+	}
+}
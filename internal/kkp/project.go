@@ -0,0 +1,133 @@
+package kkp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ---------- Per-resource project override ----------
+
+// ProjectIDResourceAttribute is the optional project_id attribute shared by all resources.
+// Resources using it must call ModifyPlanProjectID from ModifyPlan.
+func ProjectIDResourceAttribute() rschema.StringAttribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "KKP project ID. Defaults to the provider-level project_id; changing it forces a new resource.",
+	}
+}
+
+// ProjectIDDataSourceAttribute is the optional project_id attribute shared by all data sources.
+func ProjectIDDataSourceAttribute() dschema.StringAttribute {
+	return dschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "KKP project ID. Defaults to the provider-level project_id.",
+	}
+}
+
+// ProjectID returns the effective project for a project_id value: the value itself when set,
+// otherwise the provider default.
+func (rb *ResourceBase) ProjectID(v tftypes.String) string {
+	if s := TrimmedStringValue(v); s != "" {
+		return s
+	}
+	return rb.DefaultProjectID
+}
+
+// ProjectID returns the effective project for a project_id value: the value itself when set,
+// otherwise the provider default.
+func (dsb *DataSourceBase) ProjectID(v tftypes.String) string {
+	if s := TrimmedStringValue(v); s != "" {
+		return s
+	}
+	return dsb.DefaultProjectID
+}
+
+// ResolveProjectID is ProjectID for data sources that need a project. It adds an error and
+// returns false when neither the data source nor the provider sets one.
+func (dsb *DataSourceBase) ResolveProjectID(v tftypes.String, diags *diag.Diagnostics) (string, bool) {
+	projectID := dsb.ProjectID(v)
+	if projectID == "" {
+		diags.AddAttributeError(path.Root("project_id"), "Missing project_id", missingProjectIDDetail)
+		return "", false
+	}
+	return projectID, true
+}
+
+const missingProjectIDDetail = "Set 'project_id' here or in the provider configuration (KKP_PROJECT_ID)."
+
+// ModifyPlanProjectID plans a null project_id as the provider default and requires replacement
+// when the effective project of an existing resource changes. State written before project_id
+// existed (null) never forces replacement.
+func (rb *ResourceBase) ModifyPlanProjectID(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // destroy
+	}
+
+	var planned tftypes.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &planned)...)
+	if resp.Diagnostics.HasError() || planned.IsUnknown() {
+		return
+	}
+	if planned.IsNull() {
+		if rb.Client == nil {
+			return // provider not configured yet; leave the value unknown
+		}
+		if rb.DefaultProjectID == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("project_id"), "Missing project_id", missingProjectIDDetail)
+			return
+		}
+		planned = tftypes.StringValue(rb.DefaultProjectID)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_id"), planned)...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return // create
+	}
+	var prior tftypes.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &prior)...)
+	if prior.IsNull() || prior.IsUnknown() {
+		return
+	}
+	if prior.ValueString() != planned.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("project_id"))
+	}
+}
+
+// ImportProjectID strips an optional "<project_id>:" prefix from an import ID made of idParts
+// colon-separated parts, records the project in state and returns the remaining ID. Without a
+// prefix project_id stays null and Read fills in the provider default.
+func ImportProjectID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, idParts int) string {
+	id := strings.TrimSpace(req.ID)
+	parts := strings.Split(id, ":")
+	if len(parts) != idParts+1 {
+		return id
+	}
+	projectID := strings.TrimSpace(parts[0])
+	if projectID == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "The project prefix of "+strconv.Quote(req.ID)+" is empty.")
+		return ""
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	return strings.Join(parts[1:], ":")
+}
+
+// ---------- Project roles ----------
+
+// ProjectRoles are the KKP groups a user or OIDC group can be bound to within a project.
+var ProjectRoles = []string{"owners", "editors", "viewers", "projectmanagers"}
+
+// ProjectRole returns the role part of a project group as reported by KKP, which may be either
+// the plain role ("editors") or the full group name ("editors-<project_id>").
+func ProjectRole(group, projectID string) string {
+	return strings.TrimSuffix(group, "-"+projectID)
+}
@@ -0,0 +1,190 @@
+package kkp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestRetryTransport returns a retry transport with waits short enough for tests.
+func newTestRetryTransport(maxRetries int) *retryTransport {
+	return &retryTransport{
+		next:       http.DefaultTransport,
+		maxRetries: maxRetries,
+		baseWait:   time.Millisecond,
+		maxWait:    50 * time.Millisecond,
+	}
+}
+
+// statusServer answers with the given status codes in turn, then 200, and records the
+// request bodies it received.
+type statusServer struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   []string
+	header   http.Header
+}
+
+func (s *statusServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bodies = append(s.bodies, string(body))
+	status := http.StatusOK
+	if len(s.statuses) > 0 {
+		status, s.statuses = s.statuses[0], s.statuses[1:]
+		for k, v := range s.header {
+			w.Header()[k] = v
+		}
+	}
+	w.WriteHeader(status)
+}
+
+func (s *statusServer) attempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.bodies)
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, method, url, body string) *http.Response {
+	t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	_ = resp.Body.Close()
+	return resp
+}
+
+func TestRetryTransportRetriesStatuses(t *testing.T) {
+	tests := []struct {
+		status       int
+		wantAttempts int
+		wantStatus   int
+	}{
+		{http.StatusTooManyRequests, 2, http.StatusOK},
+		{http.StatusInternalServerError, 2, http.StatusOK},
+		{http.StatusBadGateway, 2, http.StatusOK},
+		{http.StatusServiceUnavailable, 2, http.StatusOK},
+		{http.StatusNotImplemented, 1, http.StatusNotImplemented},
+		{http.StatusNotFound, 1, http.StatusNotFound},
+		{http.StatusConflict, 1, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			s := &statusServer{statuses: []int{tt.status}}
+			srv := httptest.NewServer(s)
+			defer srv.Close()
+
+			resp := doRequest(t, newTestRetryTransport(3), http.MethodGet, srv.URL, "")
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := s.attempts(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportDoesNotRetryPost(t *testing.T) {
+	s := &statusServer{statuses: []int{http.StatusServiceUnavailable}}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	resp := doRequest(t, newTestRetryTransport(3), http.MethodPost, srv.URL, `{"name":"c"}`)
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", resp.StatusCode)
+	}
+	if got := s.attempts(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestRetryTransportGivesUpAfterMaxRetries(t *testing.T) {
+	s := &statusServer{statuses: []int{503, 503, 503, 503, 503, 503}}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	resp := doRequest(t, newTestRetryTransport(2), http.MethodGet, srv.URL, "")
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", resp.StatusCode)
+	}
+	if got := s.attempts(); got != 3 {
+		t.Errorf("attempts = %d, want 3 (1 + 2 retries)", got)
+	}
+}
+
+func TestRetryTransportReplaysPutBody(t *testing.T) {
+	s := &statusServer{statuses: []int{503, 502}}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	const body = `{"spec":{"replicas":3}}`
+	resp := doRequest(t, newTestRetryTransport(3), http.MethodPut, srv.URL, body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if len(s.bodies) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(s.bodies))
+	}
+	for i, got := range s.bodies {
+		if got != body {
+			t.Errorf("attempt %d body = %q, want %q", i+1, got, body)
+		}
+	}
+}
+
+func TestRetryTransportCapsRetryAfter(t *testing.T) {
+	s := &statusServer{
+		statuses: []int{http.StatusTooManyRequests},
+		header:   http.Header{"Retry-After": []string{"3600"}},
+	}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	start := time.Now()
+	resp := doRequest(t, newTestRetryTransport(3), http.MethodGet, srv.URL, "")
+	elapsed := time.Since(start)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if elapsed < 50*time.Millisecond || elapsed > 5*time.Second {
+		t.Errorf("waited %s, want about maxWait (50ms)", elapsed)
+	}
+}
+
+func TestRetryTransportBackoffHonoursRetryAfter(t *testing.T) {
+	rt := &retryTransport{baseWait: time.Millisecond, maxWait: 10 * time.Second}
+	withRetryAfter := func(v string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{v}}}
+	}
+
+	if got := rt.backoff(0, withRetryAfter("2")); got != 2*time.Second {
+		t.Errorf("Retry-After: 2 -> %s, want 2s", got)
+	}
+	if got := rt.backoff(0, withRetryAfter("3600")); got != rt.maxWait {
+		t.Errorf("Retry-After: 3600 -> %s, want maxWait %s", got, rt.maxWait)
+	}
+	date := time.Now().Add(5 * time.Second).UTC().Format(http.TimeFormat)
+	if got := rt.backoff(0, withRetryAfter(date)); got <= 3*time.Second || got > 5*time.Second {
+		t.Errorf("Retry-After: %s -> %s, want about 5s", date, got)
+	}
+	for attempt := 0; attempt < 40; attempt++ {
+		if got := rt.backoff(attempt, nil); got <= 0 || got > rt.maxWait {
+			t.Errorf("backoff(%d) = %s, want within (0, %s]", attempt, got, rt.maxWait)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package kkp
+
+import (
+	"github.com/go-openapi/runtime"
+	addonapi "github.com/kubermatic/go-kubermatic/client/addon"
+	appapi "github.com/kubermatic/go-kubermatic/client/applications"
+	presetapi "github.com/kubermatic/go-kubermatic/client/preset"
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	saapi "github.com/kubermatic/go-kubermatic/client/serviceaccounts"
+	tokenapi "github.com/kubermatic/go-kubermatic/client/tokens"
+	userapi "github.com/kubermatic/go-kubermatic/client/users"
+)
+
+// The interfaces below list only the generated client methods the provider uses, so
+// resources can be unit-tested against small fakes. The generated ClientService types
+// satisfy them; pass a nil authInfo to use the client's default authentication.
+
+// ClusterAPI covers the cluster operations of the KKP project service.
+type ClusterAPI interface {
+	ListClustersV2(params *kapi.ListClustersV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.ListClustersV2OK, error)
+	GetClusterV2(params *kapi.GetClusterV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetClusterV2OK, error)
+	CreateClusterV2(params *kapi.CreateClusterV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.CreateClusterV2Created, error)
+	PatchClusterV2(params *kapi.PatchClusterV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.PatchClusterV2OK, error)
+	DeleteClusterV2(params *kapi.DeleteClusterV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.DeleteClusterV2OK, error)
+	GetClusterHealthV2(params *kapi.GetClusterHealthV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetClusterHealthV2OK, error)
+	GetClusterEventsV2(params *kapi.GetClusterEventsV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetClusterEventsV2OK, error)
+	GetClusterKubeconfigV2(params *kapi.GetClusterKubeconfigV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetClusterKubeconfigV2OK, error)
+	ListSSHKeysAssignedToClusterV2(params *kapi.ListSSHKeysAssignedToClusterV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.ListSSHKeysAssignedToClusterV2OK, error)
+	AssignSSHKeyToClusterV2(params *kapi.AssignSSHKeyToClusterV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.AssignSSHKeyToClusterV2Created, error)
+	DetachSSHKeyFromClusterV2(params *kapi.DetachSSHKeyFromClusterV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.DetachSSHKeyFromClusterV2OK, error)
+}
+
+// MachineDeploymentAPI covers the machine deployment operations of the KKP project service.
+type MachineDeploymentAPI interface {
+	ListMachineDeployments(params *kapi.ListMachineDeploymentsParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.ListMachineDeploymentsOK, error)
+	GetMachineDeployment(params *kapi.GetMachineDeploymentParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetMachineDeploymentOK, error)
+	CreateMachineDeployment(params *kapi.CreateMachineDeploymentParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.CreateMachineDeploymentCreated, error)
+	PatchMachineDeployment(params *kapi.PatchMachineDeploymentParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.PatchMachineDeploymentOK, error)
+	DeleteMachineDeployment(params *kapi.DeleteMachineDeploymentParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.DeleteMachineDeploymentOK, error)
+}
+
+// SSHKeyAPI covers the project SSH key operations of the KKP project service.
+type SSHKeyAPI interface {
+	ListSSHKeys(params *kapi.ListSSHKeysParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.ListSSHKeysOK, error)
+	CreateSSHKey(params *kapi.CreateSSHKeyParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.CreateSSHKeyCreated, error)
+	DeleteSSHKey(params *kapi.DeleteSSHKeyParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.DeleteSSHKeyOK, error)
+}
+
+// ClusterTemplateAPI covers the cluster template operations of the KKP project service.
+type ClusterTemplateAPI interface {
+	ListClusterTemplates(params *kapi.ListClusterTemplatesParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.ListClusterTemplatesOK, error)
+	GetClusterTemplate(params *kapi.GetClusterTemplateParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetClusterTemplateOK, error)
+	CreateClusterTemplate(params *kapi.CreateClusterTemplateParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.CreateClusterTemplateCreated, error)
+	UpdateClusterTemplate(params *kapi.UpdateClusterTemplateParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.UpdateClusterTemplateCreated, error)
+	DeleteClusterTemplate(params *kapi.DeleteClusterTemplateParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.DeleteClusterTemplateOK, error)
+	CreateClusterTemplateInstance(params *kapi.CreateClusterTemplateInstanceParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.CreateClusterTemplateInstanceCreated, error)
+}
+
+// ProjectManagementAPI covers the operations on projects themselves.
+type ProjectManagementAPI interface {
+	ListProjects(params *kapi.ListProjectsParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.ListProjectsOK, error)
+	GetProject(params *kapi.GetProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetProjectOK, error)
+	CreateProject(params *kapi.CreateProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.CreateProjectCreated, error)
+	UpdateProject(params *kapi.UpdateProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.UpdateProjectOK, error)
+	DeleteProject(params *kapi.DeleteProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.DeleteProjectOK, error)
+}
+
+// GroupBindingAPI covers the group project binding operations of the KKP project service.
+type GroupBindingAPI interface {
+	ListGroupProjectBinding(params *kapi.ListGroupProjectBindingParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.ListGroupProjectBindingOK, error)
+	GetGroupProjectBinding(params *kapi.GetGroupProjectBindingParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.GetGroupProjectBindingOK, error)
+	CreateGroupProjectBinding(params *kapi.CreateGroupProjectBindingParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.CreateGroupProjectBindingCreated, error)
+	PatchGroupProjectBinding(params *kapi.PatchGroupProjectBindingParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.PatchGroupProjectBindingOK, error)
+	DeleteGroupProjectBinding(params *kapi.DeleteGroupProjectBindingParams, authInfo runtime.ClientAuthInfoWriter, opts ...kapi.ClientOption) (*kapi.DeleteGroupProjectBindingOK, error)
+}
+
+// AddonAPI covers the cluster addon operations of the KKP addon service.
+type AddonAPI interface {
+	ListAddonsV2(params *addonapi.ListAddonsV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...addonapi.ClientOption) (*addonapi.ListAddonsV2OK, error)
+	ListInstallableAddonsV2(params *addonapi.ListInstallableAddonsV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...addonapi.ClientOption) (*addonapi.ListInstallableAddonsV2OK, error)
+	GetAddonV2(params *addonapi.GetAddonV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...addonapi.ClientOption) (*addonapi.GetAddonV2OK, error)
+	CreateAddonV2(params *addonapi.CreateAddonV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...addonapi.ClientOption) (*addonapi.CreateAddonV2Created, error)
+	PatchAddonV2(params *addonapi.PatchAddonV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...addonapi.ClientOption) (*addonapi.PatchAddonV2OK, error)
+	DeleteAddonV2(params *addonapi.DeleteAddonV2Params, authInfo runtime.ClientAuthInfoWriter, opts ...addonapi.ClientOption) (*addonapi.DeleteAddonV2OK, error)
+}
+
+// ApplicationAPI covers the application installation operations of the KKP applications service.
+type ApplicationAPI interface {
+	ListApplicationInstallations(params *appapi.ListApplicationInstallationsParams, authInfo runtime.ClientAuthInfoWriter, opts ...appapi.ClientOption) (*appapi.ListApplicationInstallationsOK, error)
+	GetApplicationInstallation(params *appapi.GetApplicationInstallationParams, authInfo runtime.ClientAuthInfoWriter, opts ...appapi.ClientOption) (*appapi.GetApplicationInstallationOK, error)
+	CreateApplicationInstallation(params *appapi.CreateApplicationInstallationParams, authInfo runtime.ClientAuthInfoWriter, opts ...appapi.ClientOption) (*appapi.CreateApplicationInstallationCreated, error)
+	UpdateApplicationInstallation(params *appapi.UpdateApplicationInstallationParams, authInfo runtime.ClientAuthInfoWriter, opts ...appapi.ClientOption) (*appapi.UpdateApplicationInstallationOK, error)
+	DeleteApplicationInstallation(params *appapi.DeleteApplicationInstallationParams, authInfo runtime.ClientAuthInfoWriter, opts ...appapi.ClientOption) (*appapi.DeleteApplicationInstallationOK, error)
+}
+
+// UserAPI covers the project membership operations of the KKP users service.
+type UserAPI interface {
+	GetUsersForProject(params *userapi.GetUsersForProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...userapi.ClientOption) (*userapi.GetUsersForProjectOK, error)
+	AddUserToProject(params *userapi.AddUserToProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...userapi.ClientOption) (*userapi.AddUserToProjectCreated, error)
+	EditUserInProject(params *userapi.EditUserInProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...userapi.ClientOption) (*userapi.EditUserInProjectOK, error)
+	DeleteUserFromProject(params *userapi.DeleteUserFromProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...userapi.ClientOption) (*userapi.DeleteUserFromProjectOK, error)
+}
+
+// ServiceAccountAPI covers the project service account operations of the KKP serviceaccounts service.
+type ServiceAccountAPI interface {
+	ListServiceAccounts(params *saapi.ListServiceAccountsParams, authInfo runtime.ClientAuthInfoWriter, opts ...saapi.ClientOption) (*saapi.ListServiceAccountsOK, error)
+	AddServiceAccountToProject(params *saapi.AddServiceAccountToProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...saapi.ClientOption) (*saapi.AddServiceAccountToProjectCreated, error)
+	UpdateServiceAccount(params *saapi.UpdateServiceAccountParams, authInfo runtime.ClientAuthInfoWriter, opts ...saapi.ClientOption) (*saapi.UpdateServiceAccountOK, error)
+	DeleteServiceAccount(params *saapi.DeleteServiceAccountParams, authInfo runtime.ClientAuthInfoWriter, opts ...saapi.ClientOption) (*saapi.DeleteServiceAccountOK, error)
+}
+
+// ServiceAccountTokenAPI covers the service account token operations of the KKP tokens service.
+type ServiceAccountTokenAPI interface {
+	ListServiceAccountTokens(params *tokenapi.ListServiceAccountTokensParams, authInfo runtime.ClientAuthInfoWriter, opts ...tokenapi.ClientOption) (*tokenapi.ListServiceAccountTokensOK, error)
+	AddTokenToServiceAccount(params *tokenapi.AddTokenToServiceAccountParams, authInfo runtime.ClientAuthInfoWriter, opts ...tokenapi.ClientOption) (*tokenapi.AddTokenToServiceAccountCreated, error)
+	UpdateServiceAccountToken(params *tokenapi.UpdateServiceAccountTokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...tokenapi.ClientOption) (*tokenapi.UpdateServiceAccountTokenOK, error)
+	DeleteServiceAccountToken(params *tokenapi.DeleteServiceAccountTokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...tokenapi.ClientOption) (*tokenapi.DeleteServiceAccountTokenOK, error)
+}
+
+// PresetAPI covers the preset operations of the KKP preset service. All but the project
+// listings require an administrator token.
+type PresetAPI interface {
+	ListPresets(params *presetapi.ListPresetsParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.ListPresetsOK, error)
+	ListProjectPresets(params *presetapi.ListProjectPresetsParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.ListProjectPresetsOK, error)
+	ListProjectProviderPresets(params *presetapi.ListProjectProviderPresetsParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.ListProjectProviderPresetsOK, error)
+	CreatePreset(params *presetapi.CreatePresetParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.CreatePresetOK, error)
+	UpdatePreset(params *presetapi.UpdatePresetParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.UpdatePresetOK, error)
+	UpdatePresetStatus(params *presetapi.UpdatePresetStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.UpdatePresetStatusOK, error)
+	DeletePresetProvider(params *presetapi.DeletePresetProviderParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.DeletePresetProviderOK, error)
+	DeletePreset(params *presetapi.DeletePresetParams, authInfo runtime.ClientAuthInfoWriter, opts ...presetapi.ClientOption) (*presetapi.DeletePresetOK, error)
+}
+
+// ProjectAPI is the subset of the KKP project service used by the provider.
+type ProjectAPI interface {
+	ClusterAPI
+	MachineDeploymentAPI
+	SSHKeyAPI
+	ClusterTemplateAPI
+	ProjectManagementAPI
+	GroupBindingAPI
+}
+
+var (
+	_ ProjectAPI             = kapi.ClientService(nil)
+	_ AddonAPI               = addonapi.ClientService(nil)
+	_ ApplicationAPI         = appapi.ClientService(nil)
+	_ UserAPI                = userapi.ClientService(nil)
+	_ ServiceAccountAPI      = saapi.ClientService(nil)
+	_ ServiceAccountTokenAPI = tokenapi.ClientService(nil)
+	_ PresetAPI              = presetapi.ClientService(nil)
+)
@@ -0,0 +1,96 @@
+package kkp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = SSHPublicKeyType{}
+	_ basetypes.StringValuableWithSemanticEquals = SSHPublicKeyValue{}
+)
+
+// SSHPublicKeyType is the string type of OpenSSH public key attributes. Its values are
+// semantically equal when they hold the same key material, so surrounding whitespace and
+// comment differences between the configured key and the one KKP returns are not drift.
+type SSHPublicKeyType struct {
+	basetypes.StringType
+}
+
+func (t SSHPublicKeyType) Equal(o attr.Type) bool {
+	other, ok := o.(SSHPublicKeyType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (SSHPublicKeyType) String() string {
+	return "kkp.SSHPublicKeyType"
+}
+
+func (SSHPublicKeyType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SSHPublicKeyValue{StringValue: in}, nil
+}
+
+func (t SSHPublicKeyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T", v)
+	}
+	return SSHPublicKeyValue{StringValue: s}, nil
+}
+
+func (SSHPublicKeyType) ValueType(context.Context) attr.Value {
+	return SSHPublicKeyValue{}
+}
+
+// SSHPublicKeyValue is a value of SSHPublicKeyType.
+type SSHPublicKeyValue struct {
+	basetypes.StringValue
+}
+
+// NewSSHPublicKeyValue returns a known SSH public key value.
+func NewSSHPublicKeyValue(s string) SSHPublicKeyValue {
+	return SSHPublicKeyValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// NewSSHPublicKeyNull returns a null SSH public key value.
+func NewSSHPublicKeyNull() SSHPublicKeyValue {
+	return SSHPublicKeyValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v SSHPublicKeyValue) Equal(o attr.Value) bool {
+	other, ok := o.(SSHPublicKeyValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (SSHPublicKeyValue) Type(context.Context) attr.Type {
+	return SSHPublicKeyType{}
+}
+
+// StringSemanticEquals reports whether both values parse to the same key material. Values
+// that do not parse are only equal if they are identical, which the framework checks first.
+func (v SSHPublicKeyValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(SSHPublicKeyValue)
+	if !ok {
+		diags.AddError("Semantic equality check error", fmt.Sprintf("Expected value type %T, got %T.", v, newValuable))
+		return false, diags
+	}
+	prior, err := ParseSSHPublicKey(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	next, err := ParseSSHPublicKey(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return prior.Equal(next), diags
+}
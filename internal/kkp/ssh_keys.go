@@ -0,0 +1,281 @@
+package kkp
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // MD5 fingerprints are what KKP and ssh-keygen -E md5 report.
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+)
+
+// ---------- Cluster SSH key assignments ----------
+
+// ClusterSSHKeyIDs returns the IDs of the SSH keys assigned to a cluster.
+func ClusterSSHKeyIDs(ctx context.Context, pcli ClusterAPI, projectID, clusterID string) ([]string, error) {
+	list, err := pcli.ListSSHKeysAssignedToClusterV2(
+		kapi.NewListSSHKeysAssignedToClusterV2Params().WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterID(clusterID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	if list != nil && list.Payload != nil {
+		for _, key := range list.Payload {
+			if key == nil {
+				continue
+			}
+			ids = append(ids, key.ID)
+		}
+	}
+
+	return NormalizeIDs(ids), nil
+}
+
+// AssignClusterSSHKey assigns a project SSH key to a cluster.
+func AssignClusterSSHKey(ctx context.Context, pcli ClusterAPI, projectID, clusterID, keyID string) error {
+	params := kapi.NewAssignSSHKeyToClusterV2Params().WithContext(ctx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID).
+		WithKeyID(keyID)
+	if _, err := pcli.AssignSSHKeyToClusterV2(params, nil); err != nil {
+		return err
+	}
+	tflog.Info(ctx, "assigned SSH key to cluster", map[string]any{
+		"cluster_id": clusterID,
+		"ssh_key_id": keyID,
+	})
+	return nil
+}
+
+// DetachClusterSSHKey removes a project SSH key from a cluster.
+func DetachClusterSSHKey(ctx context.Context, pcli ClusterAPI, projectID, clusterID, keyID string) error {
+	params := kapi.NewDetachSSHKeyFromClusterV2Params().WithContext(ctx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID).
+		WithKeyID(keyID)
+	if _, err := pcli.DetachSSHKeyFromClusterV2(params, nil); err != nil {
+		return err
+	}
+	tflog.Info(ctx, "detached SSH key from cluster", map[string]any{
+		"cluster_id": clusterID,
+		"ssh_key_id": keyID,
+	})
+	return nil
+}
+
+// SyncClusterSSHKeys assigns the desired keys that are not yet on the cluster and detaches
+// the keys in previous that are no longer desired, and returns the normalized desired IDs.
+// Keys assigned by other means, e.g. kkp_cluster_ssh_key_attachment_v2, are left alone.
+func SyncClusterSSHKeys(ctx context.Context, pcli ClusterAPI, projectID, clusterID string, desired, previous []string) ([]string, error) {
+	desiredIDs := NormalizeIDs(desired)
+	currentIDs, err := ClusterSSHKeyIDs(ctx, pcli, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range desiredIDs {
+		if slices.Contains(currentIDs, id) {
+			continue
+		}
+		if err := AssignClusterSSHKey(ctx, pcli, projectID, clusterID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range NormalizeIDs(previous) {
+		if slices.Contains(desiredIDs, id) || !slices.Contains(currentIDs, id) {
+			continue
+		}
+		if err := DetachClusterSSHKey(ctx, pcli, projectID, clusterID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return desiredIDs, nil
+}
+
+// ManagedSSHKeyIDs returns the IDs of managed that are still assigned to the cluster, in the
+// order of managed. Assigned keys that are not in managed are ignored.
+func ManagedSSHKeyIDs(assigned, managed []string) []string {
+	result := make([]string, 0, len(managed))
+	for _, id := range NormalizeIDs(managed) {
+		if slices.Contains(assigned, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// NormalizeIDs trims IDs and drops empty and duplicate entries, keeping the original order.
+func NormalizeIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		trimmed := strings.TrimSpace(id)
+		if trimmed == "" {
+			continue
+		}
+		if _, ok := seen[trimmed]; ok {
+			continue
+		}
+		seen[trimmed] = struct{}{}
+		result = append(result, trimmed)
+	}
+	return result
+}
+
+// ---------- OpenSSH public keys ----------
+
+// SSHPublicKey is a parsed single-line OpenSSH public key.
+type SSHPublicKey struct {
+	Type    string // key algorithm, e.g. ssh-ed25519
+	Blob    []byte // decoded wire-format key
+	Comment string // optional trailing comment
+}
+
+// ecdsaCurves maps the ECDSA key types to the curve name embedded in the key blob.
+var ecdsaCurves = map[string]string{
+	"ecdsa-sha2-nistp256":                "nistp256",
+	"ecdsa-sha2-nistp384":                "nistp384",
+	"ecdsa-sha2-nistp521":                "nistp521",
+	"sk-ecdsa-sha2-nistp256@openssh.com": "nistp256",
+}
+
+// SupportedSSHKeyTypes lists the public key algorithms accepted for project SSH keys.
+var SupportedSSHKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+	"sk-ssh-ed25519@openssh.com",
+	"sk-ecdsa-sha2-nistp256@openssh.com",
+}
+
+// ParseSSHPublicKey parses an OpenSSH public key of the form "<type> <base64> [comment]",
+// checking that the base64 body is a well-formed key of the declared, supported type.
+func ParseSSHPublicKey(s string) (*SSHPublicKey, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return nil, errors.New("expected '<type> <base64> [comment]'")
+	}
+	keyType := fields[0]
+	if !slices.Contains(SupportedSSHKeyTypes, keyType) {
+		return nil, fmt.Errorf("unsupported key type %q (supported: %s)", keyType, strings.Join(SupportedSSHKeyTypes, ", "))
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("key body is not valid base64: %w", err)
+	}
+	parts, err := splitSSHWireStrings(blob)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 || string(parts[0]) != keyType {
+		return nil, fmt.Errorf("key body does not contain a %s key", keyType)
+	}
+	if err := checkSSHKeyFields(keyType, parts[1:]); err != nil {
+		return nil, err
+	}
+
+	return &SSHPublicKey{
+		Type:    keyType,
+		Blob:    blob,
+		Comment: strings.Join(fields[2:], " "),
+	}, nil
+}
+
+// String returns the key in canonical "<type> <base64> [comment]" form, with surrounding
+// and repeated whitespace removed.
+func (k *SSHPublicKey) String() string {
+	s := k.Type + " " + base64.StdEncoding.EncodeToString(k.Blob)
+	if k.Comment != "" {
+		s += " " + k.Comment
+	}
+	return s
+}
+
+// FingerprintMD5 returns the colon-separated hex MD5 fingerprint (ssh-keygen -E md5).
+func (k *SSHPublicKey) FingerprintMD5() string {
+	sum := md5.Sum(k.Blob) //nolint:gosec // see import
+	hexSum := hex.EncodeToString(sum[:])
+	pairs := make([]string, 0, len(sum))
+	for i := 0; i < len(hexSum); i += 2 {
+		pairs = append(pairs, hexSum[i:i+2])
+	}
+	return strings.Join(pairs, ":")
+}
+
+// FingerprintSHA256 returns the SHA256 fingerprint as printed by ssh-keygen -l.
+func (k *SSHPublicKey) FingerprintSHA256() string {
+	sum := sha256.Sum256(k.Blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// Equal reports whether both keys hold the same key material, ignoring comments.
+func (k *SSHPublicKey) Equal(other *SSHPublicKey) bool {
+	return other != nil && k.Type == other.Type && bytes.Equal(k.Blob, other.Blob)
+}
+
+// splitSSHWireStrings splits an SSH wire-format blob into its length-prefixed fields.
+func splitSSHWireStrings(blob []byte) ([][]byte, error) {
+	var parts [][]byte
+	for len(blob) > 0 {
+		if len(blob) < 4 {
+			return nil, errors.New("key body is truncated")
+		}
+		n := binary.BigEndian.Uint32(blob)
+		blob = blob[4:]
+		if uint64(n) > uint64(len(blob)) {
+			return nil, errors.New("key body is truncated")
+		}
+		parts = append(parts, blob[:n])
+		blob = blob[n:]
+	}
+	return parts, nil
+}
+
+// checkSSHKeyFields validates the fields following the key type in the blob.
+func checkSSHKeyFields(keyType string, fields [][]byte) error {
+	switch keyType {
+	case "ssh-rsa":
+		// exponent, modulus
+		if len(fields) != 2 || len(fields[0]) == 0 || len(fields[1]) == 0 {
+			return errors.New("malformed ssh-rsa key")
+		}
+	case "ssh-ed25519", "sk-ssh-ed25519@openssh.com":
+		// key [, application]
+		want := 1
+		if strings.HasPrefix(keyType, "sk-") {
+			want = 2
+		}
+		if len(fields) != want || len(fields[0]) != 32 {
+			return fmt.Errorf("malformed %s key", keyType)
+		}
+	default:
+		// curve, point [, application]
+		want := 2
+		if strings.HasPrefix(keyType, "sk-") {
+			want = 3
+		}
+		if len(fields) != want || string(fields[0]) != ecdsaCurves[keyType] || len(fields[1]) == 0 {
+			return fmt.Errorf("malformed %s key", keyType)
+		}
+	}
+	return nil
+}
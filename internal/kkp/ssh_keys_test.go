@@ -0,0 +1,175 @@
+package kkp
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+const (
+	testEd25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAID15obI36+rA24ItLwBCD71kmIBHqFhLK9xtB0wk8mQz me@host"
+	testECDSAKey   = "ecdsa-sha2-nistp384 AAAAE2VjZHNhLXNoYTItbmlzdHAzODQAAAAIbmlzdHAzODQAAABhBMFnlc+cI/2t2IN3WvnU0b6HcSjOU/qo6rcT85Q+UJTijO0KeMSmzYKV6ITA6iv8aLcImcna4bw2NiA1iA37/ofd10uf0p04HLE/WFuDQzCZLLgaPUU7OAYSBPXSSUDn3A=="
+)
+
+func TestParseSSHPublicKeyFingerprints(t *testing.T) {
+	tests := []struct {
+		name, key, md5, sha256 string
+	}{
+		{
+			name:   "ed25519",
+			key:    testEd25519Key,
+			md5:    "12:24:6f:b3:d7:d7:df:8f:30:bb:91:d3:80:3c:67:3f",
+			sha256: "SHA256:fF8qiuW+WWJgVZcM9Xk1xzB4txzeF6yFMIxsXkXexCE",
+		},
+		{
+			name:   "ecdsa",
+			key:    testECDSAKey,
+			md5:    "6c:02:fe:fb:e6:d2:d6:18:ce:5e:ea:82:59:59:c5:a3",
+			sha256: "SHA256:2xIDf+Gu+xRYitKEeiGRuelCFkesWLpX7ITYdVZBCOg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := ParseSSHPublicKey(tt.key)
+			if err != nil {
+				t.Fatalf("ParseSSHPublicKey: %v", err)
+			}
+			if got := k.FingerprintMD5(); got != tt.md5 {
+				t.Errorf("FingerprintMD5 = %s, want %s", got, tt.md5)
+			}
+			if got := k.FingerprintSHA256(); got != tt.sha256 {
+				t.Errorf("FingerprintSHA256 = %s, want %s", got, tt.sha256)
+			}
+		})
+	}
+}
+
+func TestParseSSHPublicKeyNormalizes(t *testing.T) {
+	k, err := ParseSSHPublicKey("  " + strings.ReplaceAll(testEd25519Key, " ", "\t ") + "\n")
+	if err != nil {
+		t.Fatalf("ParseSSHPublicKey: %v", err)
+	}
+	if got := k.String(); got != testEd25519Key {
+		t.Errorf("String = %q, want %q", got, testEd25519Key)
+	}
+
+	other, err := ParseSSHPublicKey(strings.TrimSuffix(testEd25519Key, " me@host"))
+	if err != nil {
+		t.Fatalf("ParseSSHPublicKey: %v", err)
+	}
+	if !k.Equal(other) {
+		t.Error("keys differing only in comment should be equal")
+	}
+}
+
+func TestParseSSHPublicKeyRejectsInvalid(t *testing.T) {
+	body := strings.Fields(testEd25519Key)[1]
+	tests := map[string]string{
+		"missing body":      "ssh-ed25519",
+		"unsupported type":  "ssh-dss " + body,
+		"bad base64":        "ssh-ed25519 not*base64",
+		"type mismatch":     "ssh-rsa " + body,
+		"truncated":         "ssh-ed25519 " + body[:40],
+		"wrong ecdsa curve": strings.Replace(testECDSAKey, "nistp384", "nistp256", 1),
+	}
+	for name, key := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseSSHPublicKey(key); err == nil {
+				t.Errorf("ParseSSHPublicKey(%q) succeeded, want error", key)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyValueSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		other string
+		want  bool
+	}{
+		{"trailing newline", testEd25519Key + "\n", true},
+		{"comment changed", strings.Replace(testEd25519Key, "me@host", "other@host", 1), true},
+		{"comment removed", strings.TrimSuffix(testEd25519Key, " me@host"), true},
+		{"different key", testECDSAKey, false},
+		{"invalid", "ssh-ed25519 not*base64", false},
+	}
+	prior := NewSSHPublicKeyValue(testEd25519Key)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := prior.StringSemanticEquals(context.Background(), NewSSHPublicKeyValue(tt.other))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeClusterSSHKeys records SSH key assignments of a single cluster.
+type fakeClusterSSHKeys struct {
+	ClusterAPI
+	assigned []string
+}
+
+func (f *fakeClusterSSHKeys) ListSSHKeysAssignedToClusterV2(*kapi.ListSSHKeysAssignedToClusterV2Params, runtime.ClientAuthInfoWriter, ...kapi.ClientOption) (*kapi.ListSSHKeysAssignedToClusterV2OK, error) {
+	out := kapi.NewListSSHKeysAssignedToClusterV2OK()
+	for _, id := range f.assigned {
+		out.Payload = append(out.Payload, &models.SSHKey{ID: id})
+	}
+	return out, nil
+}
+
+func (f *fakeClusterSSHKeys) AssignSSHKeyToClusterV2(params *kapi.AssignSSHKeyToClusterV2Params, _ runtime.ClientAuthInfoWriter, _ ...kapi.ClientOption) (*kapi.AssignSSHKeyToClusterV2Created, error) {
+	f.assigned = append(f.assigned, params.KeyID)
+	return kapi.NewAssignSSHKeyToClusterV2Created(), nil
+}
+
+func (f *fakeClusterSSHKeys) DetachSSHKeyFromClusterV2(params *kapi.DetachSSHKeyFromClusterV2Params, _ runtime.ClientAuthInfoWriter, _ ...kapi.ClientOption) (*kapi.DetachSSHKeyFromClusterV2OK, error) {
+	f.assigned = slices.DeleteFunc(f.assigned, func(id string) bool { return id == params.KeyID })
+	return kapi.NewDetachSSHKeyFromClusterV2OK(), nil
+}
+
+func TestSyncClusterSSHKeysLeavesOtherKeysAlone(t *testing.T) {
+	tests := []struct {
+		name     string
+		assigned []string
+		desired  []string
+		previous []string
+		want     []string
+	}{
+		{"create keeps foreign key", []string{"breakglass"}, []string{"admin"}, nil, []string{"breakglass", "admin"}},
+		{"no change", []string{"admin", "breakglass"}, []string{"admin"}, []string{"admin"}, []string{"admin", "breakglass"}},
+		{"removed key is detached", []string{"admin", "old", "breakglass"}, []string{"admin"}, []string{"admin", "old"}, []string{"admin", "breakglass"}},
+		{"removed key already gone", []string{"admin"}, []string{"admin"}, []string{"admin", "old"}, []string{"admin"}},
+		{"re-adds a detached key", []string{"breakglass"}, []string{"admin"}, []string{"admin"}, []string{"breakglass", "admin"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeClusterSSHKeys{assigned: slices.Clone(tt.assigned)}
+			got, err := SyncClusterSSHKeys(context.Background(), f, "p", "c", tt.desired, tt.previous)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.desired) {
+				t.Errorf("returned %v, want %v", got, tt.desired)
+			}
+			if !slices.Equal(f.assigned, tt.want) {
+				t.Errorf("assigned = %v, want %v", f.assigned, tt.want)
+			}
+		})
+	}
+}
+
+func TestManagedSSHKeyIDs(t *testing.T) {
+	got := ManagedSSHKeyIDs([]string{"breakglass", "b", "a"}, []string{"a", "b", "gone"})
+	if want := []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("ManagedSSHKeyIDs = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,172 @@
+package kkp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// okTransport answers every request with 200 and a small body.
+var okTransport = roundTripFunc(func(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+})
+
+func throttleRequest(t *testing.T, ctx context.Context) *http.Request {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://kkp.example.com/api/v2/projects", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestTokenBucketPacesRequests(t *testing.T) {
+	b := newTokenBucket(20) // burst of 20, then one token every 50ms
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		if err := b.wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if burst := time.Since(start); burst > 40*time.Millisecond {
+		t.Fatalf("burst took %s, want immediate", burst)
+	}
+
+	for i := 0; i < 4; i++ {
+		if err := b.wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if paced := time.Since(start); paced < 150*time.Millisecond || paced > 2*time.Second {
+		t.Fatalf("4 requests past the burst took %s in total, want about 200ms", paced)
+	}
+}
+
+func TestTokenBucketWaiterUnblocksOnCancel(t *testing.T) {
+	b := newTokenBucket(0.1) // one token, then one every 10s
+	if err := b.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- b.wait(ctx) }()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("got %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter was not released by cancellation")
+	}
+
+	b.mu.Lock()
+	tokens := b.tokens
+	b.mu.Unlock()
+	if tokens < -0.01 {
+		t.Errorf("cancelled reservation was not returned: tokens = %f", tokens)
+	}
+}
+
+func TestThrottleTransportReleasesSlotOnBodyClose(t *testing.T) {
+	rt := newThrottleTransport(okTransport, 0, 1)
+
+	resp, err := rt.RoundTrip(throttleRequest(t, context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The only slot is held until the body is closed.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := rt.RoundTrip(throttleRequest(t, ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("second request while slot held: got %v, want context.DeadlineExceeded", err)
+	}
+
+	_ = resp.Body.Close()
+	_ = resp.Body.Close() // closing twice must not release twice
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err = rt.RoundTrip(throttleRequest(t, ctx))
+	if err != nil {
+		t.Fatalf("request after body close: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if n := len(rt.(*throttleTransport).slots); n != 0 {
+		t.Fatalf("%d slots still held, want 0", n)
+	}
+}
+
+func TestThrottleTransportReleasesSlotOnError(t *testing.T) {
+	boom := errors.New("connection reset")
+	failing := roundTripFunc(func(*http.Request) (*http.Response, error) { return nil, boom })
+	rt := newThrottleTransport(failing, 0, 1)
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := rt.RoundTrip(throttleRequest(t, ctx))
+		cancel()
+		if !errors.Is(err, boom) {
+			t.Fatalf("attempt %d: got %v, want the transport error (slot leaked?)", i+1, err)
+		}
+	}
+}
+
+func TestThrottleTransportReleasesSlotWhenRateWaitIsCancelled(t *testing.T) {
+	rt := newThrottleTransport(okTransport, 0.1, 1)
+
+	resp, err := rt.RoundTrip(throttleRequest(t, context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	// The bucket is empty, so this request gets a slot and then waits for a token.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := rt.RoundTrip(throttleRequest(t, ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if n := len(rt.(*throttleTransport).slots); n != 0 {
+		t.Fatalf("%d slots still held after cancelled rate wait, want 0", n)
+	}
+}
+
+func TestThrottleTransportSlotWaiterUnblocksOnCancel(t *testing.T) {
+	rt := newThrottleTransport(okTransport, 0, 1)
+	resp, err := rt.RoundTrip(throttleRequest(t, context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := throttleRequest(t, ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := rt.RoundTrip(req)
+		errCh <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("got %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("slot waiter was not released by cancellation")
+	}
+}
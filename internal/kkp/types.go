@@ -6,6 +6,7 @@ import (
 	"time"
 
 	httptransport "github.com/go-openapi/runtime/client"
+	gok "github.com/kubermatic/go-kubermatic/client"
 )
 
 // ProviderMeta is passed from provider.Configure to resources/datasources.
@@ -14,9 +15,10 @@ type ProviderMeta struct {
 	DefaultProjectID string
 }
 
-// headerAuthWriter injects Authorization/User-Agent/extra headers on each request.
+// headerAuthWriter injects User-Agent/extra headers on each request and holds the token
+// source bearerTransport uses for the Authorization header.
 type headerAuthWriter struct {
-	token       string
+	tokens      TokenSource // nil sends no Authorization header
 	userAgent   string
 	extraHeader map[string]string
 }
@@ -30,26 +32,55 @@ type Config struct {
 	// Bearer token for KKP REST.
 	Token string
 
+	// Alternative credential sources; at most one should be set. They take precedence over Token.
+	OIDC         *OIDCConfig // obtain and refresh tokens from an OIDC provider
+	TokenFile    string      // read the token from a file, re-read when it changes
+	TokenCommand []string    // run a command printing a token or an ExecCredential
+
 	// TLS
 	InsecureSkipVerify bool
 	CAFile             string // optional PEM bundle appended to system pool
+	CAPEM              string // optional inline PEM bundle appended to system pool
+	ClientCert         string // optional PEM client certificate for mTLS; requires ClientKey
+	ClientKey          string // optional PEM private key matching ClientCert
 
 	// HTTP
-	Timeout      time.Duration     // default 60s
+	Timeout      time.Duration     // per API call, including retries and waits; default 60s
 	UserAgent    string            // default "terraform-provider-kkp"
 	ExtraHeaders map[string]string // optional extra headers for every request
+	ProxyURL     string            // optional proxy; default honors HTTP(S)_PROXY/NO_PROXY
+
+	// Retries for transient failures (429/5xx/connection resets) on idempotent requests
+	MaxRetries   int           // 0 disables retries
+	RetryMaxWait time.Duration // cap for a single backoff or Retry-After wait; default 30s
+
+	// Client-side throttling shared by all resources and data sources
+	RequestsPerSecond     float64 // 0 disables rate limiting
+	MaxConcurrentRequests int     // 0 disables the concurrency cap
 }
 
 // Client is a thin wrapper around the generated Go client.
 type Client struct {
-	// API is the generated client instance (branch-dependent type).
-	// Avoid hardcoding the concrete type name here; resources can type assert.
-	API any
+	// API is the generated client. Prefer the typed services below; use API only for
+	// services that do not have an interface in this package yet.
+	API *gok.KubermaticKubernetesPlatformAPI
+
+	// Typed service clients sharing Transport. Tests may set fakes directly.
+	Project         ProjectAPI
+	Addons          AddonAPI
+	Applications    ApplicationAPI
+	Users           UserAPI
+	ServiceAccounts ServiceAccountAPI
+	Tokens          ServiceAccountTokenAPI
+	Presets         PresetAPI
 
 	// Underlying pieces in case you need them.
 	Transport  *httptransport.Runtime
 	HTTPClient *http.Client
 	BaseURL    *url.URL
+
+	// cache deduplicates and briefly caches list/get requests of this provider instance.
+	cache *requestCache
 }
 
 // ClusterHealthChecker provides cluster health checking functionality
@@ -57,6 +88,7 @@ type ClusterHealthChecker struct {
 	Client    *Client
 	ProjectID string
 	ClusterID string
+	Readiness ReadinessPolicy // Optional: zero value applies the default readiness policy
 }
 
 // ClusterUpdateSpec holds the expected values after an update
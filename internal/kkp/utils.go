@@ -52,38 +52,86 @@ func stripTrailingAPI(p string) string {
 	return clean
 }
 
-func buildTLSConfig(insecure bool, caFile string) (*tls.Config, error) {
+func buildTLSConfig(cfg Config) (*tls.Config, error) {
 	rootCAs, _ := x509.SystemCertPool()
 	if rootCAs == nil {
 		rootCAs = x509.NewCertPool()
 	}
-	if caFile != "" {
-		pem, err := os.ReadFile(caFile) // #nosec G304 -- Reading CA file is legitimate in TLS config
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile) // #nosec G304 -- Reading CA file is legitimate in TLS config
 		if err != nil {
 			return nil, fmt.Errorf("read CAFile: %w", err)
 		}
 		if ok := rootCAs.AppendCertsFromPEM(pem); !ok {
-			return nil, fmt.Errorf("failed to append certs from %s", caFile)
+			return nil, fmt.Errorf("failed to append certs from %s", cfg.CAFile)
 		}
 	}
-	return &tls.Config{
-		InsecureSkipVerify: insecure, //nolint:gosec // user-configurable for dev/self-signed endpoints
+	if strings.TrimSpace(cfg.CAPEM) != "" {
+		if ok := rootCAs.AppendCertsFromPEM([]byte(cfg.CAPEM)); !ok {
+			return nil, fmt.Errorf("failed to append certs from inline CA PEM: no valid certificate found")
+		}
+	}
+
+	tlsCfg := &tls.Config{
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // user-configurable for dev/self-signed endpoints
 		RootCAs:            rootCAs,
 		MinVersion:         tls.VersionTLS12,
-	}, nil
+	}
+
+	hasCert, hasKey := strings.TrimSpace(cfg.ClientCert) != "", strings.TrimSpace(cfg.ClientKey) != ""
+	switch {
+	case hasCert && hasKey:
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCert), []byte(cfg.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	case hasCert || hasKey:
+		return nil, fmt.Errorf("client certificate and client key must be set together")
+	}
+	return tlsCfg, nil
 }
 
-func newHTTPClient(timeout time.Duration, tlsCfg *tls.Config) *http.Client {
+func newHTTPClient(cfg Config, tlsCfg *tls.Config, cache *requestCache, auth *headerAuthWriter) (*http.Client, error) {
+	timeout := cfg.Timeout
 	if timeout <= 0 {
 		timeout = 60 * time.Second
 	}
+	proxy, err := proxyFunc(cfg.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+	base := &http.Transport{
+		Proxy:           proxy,
+		TLSClientConfig: tlsCfg,
+	}
+	// Throttle every attempt, including retries, so retries cannot exceed the configured limits.
+	throttled := newThrottleTransport(base, cfg.RequestsPerSecond, cfg.MaxConcurrentRequests)
+	var transport http.RoundTripper = newRetryTransport(throttled, cfg.MaxRetries, cfg.RetryMaxWait)
+	if cache != nil {
+		transport = &invalidatingTransport{next: transport, cache: cache}
+	}
+	if auth != nil {
+		// Obtain the token once per request rather than once per retry.
+		transport = &bearerTransport{next: transport, auth: auth}
+	}
 	return &http.Client{
-		Transport: &http.Transport{
-			Proxy:           http.ProxyFromEnvironment,
-			TLSClientConfig: tlsCfg,
-		},
-		Timeout: timeout,
+		Transport: transport,
+		Timeout:   timeout,
+	}, nil
+}
+
+// proxyFunc returns a proxy selector for proxyURL, or the environment-based default when it is empty.
+func proxyFunc(proxyURL string) (func(*http.Request) (*url.URL, error), error) {
+	v := strings.TrimSpace(proxyURL)
+	if v == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	u, err := url.Parse(v)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: expected scheme://host[:port]", v)
 	}
+	return http.ProxyURL(u), nil
 }
 
 func defaultUA(ua string) string {
@@ -140,6 +188,22 @@ func IsAttributeSet(attr interface{}) bool {
 	}
 }
 
+// BoolValueOrDefault returns the attribute value when set, otherwise def.
+func BoolValueOrDefault(attr tftypes.Bool, def bool) bool {
+	if attr.IsNull() || attr.IsUnknown() {
+		return def
+	}
+	return attr.ValueBool()
+}
+
+// Int64ValueOrDefault returns the attribute value when set, otherwise def.
+func Int64ValueOrDefault(attr tftypes.Int64, def int64) int64 {
+	if attr.IsNull() || attr.IsUnknown() {
+		return def
+	}
+	return attr.ValueInt64()
+}
+
 // MergeInt64 copies src into dst when dst is null or unknown.
 func MergeInt64(dst *tftypes.Int64, src tftypes.Int64) {
 	if dst == nil {
@@ -30,12 +30,7 @@ func (c *Client) GetServerVersion(ctx context.Context) (string, error) {
 		// headers
 		req.Header.Set("User-Agent", defaultUA(""))
 		if aw, ok := c.Transport.DefaultAuthentication.(*headerAuthWriter); ok {
-			if aw.token != "" {
-				req.Header.Set("Authorization", "Bearer "+aw.token)
-			}
-			for k, v := range aw.extraHeader {
-				req.Header.Set(k, v)
-			}
+			aw.setHeaders(req)
 		}
 
 		resp, err := c.HTTPClient.Do(req)
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables used when the matching provider attribute is not set.
+const (
+	envEndpoint           = "KKP_ENDPOINT"
+	envToken              = "KKP_TOKEN"
+	envProjectID          = "KKP_PROJECT_ID"
+	envInsecureSkipVerify = "KKP_INSECURE_SKIP_VERIFY"
+	envCAFile             = "KKP_CA_FILE"
+)
+
+// stringOrEnv returns the configured value, or the environment variable when the attribute is null or empty.
+func stringOrEnv(v tftypes.String, env string) string {
+	if s := strings.TrimSpace(v.ValueString()); s != "" {
+		return s
+	}
+	return strings.TrimSpace(os.Getenv(env))
+}
+
+// boolOrEnv returns the configured value, or the parsed environment variable when the attribute is null.
+func boolOrEnv(v tftypes.Bool, env string) (bool, error) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueBool(), nil
+	}
+	raw := strings.TrimSpace(os.Getenv(env))
+	if raw == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("expected true or false in %s, got %q", env, raw)
+	}
+	return b, nil
+}
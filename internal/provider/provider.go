@@ -3,27 +3,51 @@ package provider
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	pframework "github.com/hashicorp/terraform-plugin-framework/provider"
 	pschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	tflog "github.com/hashicorp/terraform-plugin-log/tflog"
 
 	data_source_addon_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/addon_v2"
 	data_source_application_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/application_v2"
+	data_source_cluster_health_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/cluster_health_v2"
 	data_source_cluster_kubeconfig_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/cluster_kubeconfig_v2"
 	data_source_cluster_template_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/cluster_template_v2"
 	data_source_cluster_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/cluster_v2"
 	data_source_machine_deployment_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/machine_deployment_v2"
+	data_source_preset_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/preset_v2"
+	data_source_project_member_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/project_member_v2"
+	data_source_project_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/project_v2"
 	data_source_ssh_key_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/data/ssh_key_v2"
 	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
 	resource_addon_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/addon_v2"
 	resource_application_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/application_v2"
+	resource_cluster_readiness_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/cluster_readiness_v2"
+	resource_cluster_ssh_key_attachment_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/cluster_ssh_key_attachment_v2"
+	resource_cluster_template_instance_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/cluster_template_instance_v2"
+	resource_cluster_template_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/cluster_template_v2"
 	resource_cluster_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/cluster_v2"
 	resource_machine_deployment_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/machine_deployment_v2"
+	resource_preset_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/preset_v2"
+	resource_project_group_binding_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/project_group_binding_v2"
+	resource_project_member_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/project_member_v2"
+	resource_project_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/project_v2"
+	resource_service_account_token_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/service_account_token_v2"
+	resource_service_account_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/service_account_v2"
 	resource_ssh_key_v2 "github.com/armagankaratosun/terraform-provider-kkp/internal/resources/ssh_key_v2"
 )
 
@@ -31,12 +55,38 @@ var _ pframework.Provider = &KKPProvider{}
 
 // Config represents the provider configuration
 type Config struct {
-	Endpoint           tftypes.String `tfsdk:"endpoint"`
-	Token              tftypes.String `tfsdk:"token"`
-	InsecureSkipVerify tftypes.Bool   `tfsdk:"insecure_skip_verify"`
-	ProjectID          tftypes.String `tfsdk:"project_id"`
+	Endpoint           tftypes.String  `tfsdk:"endpoint"`
+	Token              tftypes.String  `tfsdk:"token"`
+	InsecureSkipVerify tftypes.Bool    `tfsdk:"insecure_skip_verify"`
+	ProjectID          tftypes.String  `tfsdk:"project_id"`
+	MaxRetries         tftypes.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait       tftypes.String  `tfsdk:"retry_max_wait"`
+	RequestsPerSecond  tftypes.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrent      tftypes.Int64   `tfsdk:"max_concurrent_requests"`
+	CAFile             tftypes.String  `tfsdk:"ca_file"`
+	CAPEM              tftypes.String  `tfsdk:"ca_pem"`
+	ClientCert         tftypes.String  `tfsdk:"client_cert"`
+	ClientKey          tftypes.String  `tfsdk:"client_key"`
+	ProxyURL           tftypes.String  `tfsdk:"proxy_url"`
+	ExtraHeaders       tftypes.Map     `tfsdk:"extra_headers"`
+	Timeout            tftypes.String  `tfsdk:"timeout"`
+	TokenFile          tftypes.String  `tfsdk:"token_file"`
+	TokenCommand       tftypes.List    `tfsdk:"token_command"`
+	OIDC               *oidcConfig     `tfsdk:"oidc"`
 }
 
+// oidcConfig represents the optional oidc block.
+type oidcConfig struct {
+	IssuerURL    tftypes.String `tfsdk:"issuer_url"`
+	ClientID     tftypes.String `tfsdk:"client_id"`
+	ClientSecret tftypes.String `tfsdk:"client_secret"`
+	RefreshToken tftypes.String `tfsdk:"refresh_token"`
+	Scopes       tftypes.List   `tfsdk:"scopes"`
+}
+
+// defaultTimeout is the per-request timeout used when `timeout` is not set.
+const defaultTimeout = 60 * time.Second
+
 // KKPProvider implements the KKP Terraform provider.
 type KKPProvider struct {
 	version string
@@ -58,21 +108,127 @@ func (p *KKPProvider) Schema(_ context.Context, _ pframework.SchemaRequest, resp
 	resp.Schema = pschema.Schema{
 		Attributes: map[string]pschema.Attribute{
 			"endpoint": pschema.StringAttribute{
-				Required:    true,
-				Description: "KKP API base URL (with or without /api), e.g. https://kkp.example.com or https://kkp.example.com/api",
+				Optional:    true,
+				Description: "KKP API base URL (with or without /api), e.g. https://kkp.example.com or https://kkp.example.com/api. Can also be set with the `KKP_ENDPOINT` environment variable.",
 			},
 			"token": pschema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Bearer token for KKP API (use a project Service Account in the 'Editor' group to manage SSH keys).",
+				Description: "Bearer token for KKP API (use a project Service Account in the 'Editor' group to manage SSH keys; see kkp_service_account_v2). Can also be set with the `KKP_TOKEN` environment variable.",
+			},
+			"token_file": pschema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing the bearer token, e.g. written by a sidecar. The file is re-read whenever it changes. Alternative to `token`.",
+			},
+			"token_command": pschema.ListAttribute{
+				ElementType: tftypes.StringType,
+				Optional:    true,
+				Description: "Command and arguments printing a bearer token or a Kubernetes ExecCredential JSON document to stdout. The token is cached until shortly before its expiry (`status.expirationTimestamp` or the JWT `exp` claim); tokens without expiry are reused for the whole run. Alternative to `token`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
 			},
 			"insecure_skip_verify": pschema.BoolAttribute{
 				Optional:    true,
-				Description: "Skip TLS cert verification (dev/test only).",
+				Description: "Skip TLS cert verification (dev/test only). Can also be set with the `KKP_INSECURE_SKIP_VERIFY` environment variable.",
 			},
 			"project_id": pschema.StringAttribute{
-				Required:    true,
-				Description: "Project ID used by all resources.",
+				Optional:    true,
+				Description: "Default project ID for project-scoped resources and data sources, which can override it with their own project_id. Can also be set with the `KKP_PROJECT_ID` environment variable.",
+			},
+			"ca_file": pschema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM bundle of CA certificates trusted in addition to the system pool. Can also be set with the `KKP_CA_FILE` environment variable.",
+			},
+			"ca_pem": pschema.StringAttribute{
+				Optional:    true,
+				Description: "Inline PEM bundle of CA certificates trusted in addition to the system pool and `ca_file`.",
+			},
+			"client_cert": pschema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate for mutual TLS. Requires `client_key`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": pschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded private key for `client_cert`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"proxy_url": pschema.StringAttribute{
+				Optional:    true,
+				Description: "Proxy URL for all API requests, e.g. http://proxy.example.com:3128. Defaults to the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.",
+			},
+			"extra_headers": pschema.MapAttribute{
+				ElementType: tftypes.StringType,
+				Optional:    true,
+				Description: "Additional HTTP headers sent with every API request, e.g. for an API gateway. The Authorization header is set from the configured credential source (`token`, `token_file`, `token_command` or `oidc`) and cannot be set here.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive("Authorization")),
+				},
+			},
+			"timeout": pschema.StringAttribute{
+				Optional:    true,
+				Description: "Time limit for a single API call as a Go duration, e.g. 60s or 2m (default 60s). It covers the call's retries, rate-limit waits and obtaining a token; waits for clusters and other long-running operations have their own timeouts.",
+			},
+			"max_retries": pschema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for idempotent API requests that fail with 429, a 5xx status or a connection reset (default 3, 0 disables retries).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": pschema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum wait between two retries as a Go duration, e.g. 30s or 1m (default 30s). Also caps Retry-After values sent by the server.",
+			},
+			"requests_per_second": pschema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum sustained rate of API requests shared by all resources and data sources, including polls and retries (default unlimited).",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": pschema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests in flight at the same time across all resources and data sources (default unlimited).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]pschema.Block{
+			"oidc": pschema.SingleNestedBlock{
+				Description: "Obtain and refresh API tokens from an OIDC provider such as Dex or Keycloak instead of using a static `token`. Uses the refresh_token grant when `refresh_token` is set and the client_credentials grant otherwise. Tokens are cached in memory only and refreshed shortly before they expire.",
+				Attributes: map[string]pschema.Attribute{
+					"issuer_url": pschema.StringAttribute{
+						Optional:    true,
+						Description: "OIDC issuer URL (required), e.g. https://kkp.example.com/dex. The token endpoint is read from its discovery document.",
+					},
+					"client_id": pschema.StringAttribute{
+						Optional:    true,
+						Description: "OIDC client ID (required).",
+					},
+					"client_secret": pschema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "OIDC client secret. Omit for public clients.",
+					},
+					"refresh_token": pschema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Refresh token used to obtain tokens. Rotated refresh tokens returned by the issuer are kept in memory for the rest of the run.",
+					},
+					"scopes": pschema.ListAttribute{
+						ElementType: tftypes.StringType,
+						Optional:    true,
+						Description: "Scopes to request (default [\"openid\"]).",
+					},
+				},
 			},
 		},
 	}
@@ -86,26 +242,122 @@ func (p *KKPProvider) Configure(ctx context.Context, req pframework.ConfigureReq
 		return
 	}
 
-	endpoint := cfg.Endpoint.ValueString()
-	token := cfg.Token.ValueString()
-	projectID := cfg.ProjectID.ValueString()
-	insecure := false
-	if !cfg.InsecureSkipVerify.IsNull() && !cfg.InsecureSkipVerify.IsUnknown() {
-		insecure = cfg.InsecureSkipVerify.ValueBool()
+	// Values known only after apply cannot configure the client; fail with a clear message
+	// instead of silently falling back to the environment.
+	for _, a := range []struct {
+		name  string
+		value tftypes.String
+	}{{"endpoint", cfg.Endpoint}, {"token", cfg.Token}, {"project_id", cfg.ProjectID}} {
+		if a.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(path.Root(a.name), "Unknown provider setting", "'"+a.name+"' depends on a value that is not known until apply. Set it statically or via its environment variable.")
+		}
+	}
+	if cfg.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Unknown provider setting", "'insecure_skip_verify' depends on a value that is not known until apply.")
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if endpoint == "" || token == "" || projectID == "" {
-		resp.Diagnostics.AddError("Missing required provider settings", "'endpoint', 'token', and 'project_id' must be configured.")
+	endpoint := stringOrEnv(cfg.Endpoint, envEndpoint)
+	token := stringOrEnv(cfg.Token, envToken)
+	projectID := stringOrEnv(cfg.ProjectID, envProjectID)
+	insecure, err := boolOrEnv(cfg.InsecureSkipVerify, envInsecureSkipVerify)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid "+envInsecureSkipVerify, err.Error())
 		return
 	}
 
+	oidc := buildOIDCConfig(ctx, cfg.OIDC, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tokenFile := kkp.TrimmedStringValue(cfg.TokenFile)
+	var tokenCommand []string
+	if !cfg.TokenCommand.IsNull() && !cfg.TokenCommand.IsUnknown() {
+		resp.Diagnostics.Append(cfg.TokenCommand.ElementsAs(ctx, &tokenCommand, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Exactly one credential source: token (or KKP_TOKEN), token_file, token_command or oidc.
+	var sources []string
+	if !cfg.Token.IsNull() {
+		sources = append(sources, "token")
+	}
+	if tokenFile != "" {
+		sources = append(sources, "token_file")
+	}
+	if len(tokenCommand) > 0 {
+		sources = append(sources, "token_command")
+	}
+	if oidc != nil {
+		sources = append(sources, "oidc")
+	}
+	if len(sources) > 1 {
+		resp.Diagnostics.AddError("Conflicting provider settings", "Only one of 'token', 'token_file', 'token_command' and the 'oidc' block may be set, got "+strings.Join(sources, ", ")+".")
+		return
+	}
+	hasCredentialSource := tokenFile != "" || len(tokenCommand) > 0 || oidc != nil
+	if hasCredentialSource {
+		token = ""
+	}
+
+	for _, a := range []struct{ name, value, env string }{
+		{"endpoint", endpoint, envEndpoint},
+		{"token", token, envToken},
+	} {
+		if a.value != "" || (a.name == "token" && hasCredentialSource) {
+			continue
+		}
+		detail := "Set '" + a.name + "' in the provider block or the " + a.env + " environment variable."
+		if a.name == "token" {
+			detail = "Set 'token', 'token_file', 'token_command' or the 'oidc' block in the provider block, or the " + a.env + " environment variable."
+		}
+		resp.Diagnostics.AddAttributeError(path.Root(a.name), "Missing required provider setting", detail)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxRetries := kkp.Int64ValueOrDefault(cfg.MaxRetries, kkp.DefaultMaxRetries)
+	retryMaxWait, ok := parseDurationAttribute(&resp.Diagnostics, cfg.RetryMaxWait, "retry_max_wait", kkp.DefaultRetryMaxWait)
+	if !ok {
+		return
+	}
+	timeout, ok := parseDurationAttribute(&resp.Diagnostics, cfg.Timeout, "timeout", defaultTimeout)
+	if !ok {
+		return
+	}
+
+	extraHeaders := map[string]string{}
+	if !cfg.ExtraHeaders.IsNull() && !cfg.ExtraHeaders.IsUnknown() {
+		resp.Diagnostics.Append(cfg.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	client, err := kkp.NewHTTPClient(kkp.Config{
-		Endpoint:           endpoint,
-		Token:              token,
-		InsecureSkipVerify: insecure,
-		Timeout:            60 * time.Second,
-		UserAgent:          "terraform-provider-kkp/" + p.version,
-		// CAFile:          cfg.CAFile.ValueString(), // if you expose it
+		Endpoint:              endpoint,
+		Token:                 token,
+		OIDC:                  oidc,
+		TokenFile:             tokenFile,
+		TokenCommand:          tokenCommand,
+		InsecureSkipVerify:    insecure,
+		CAFile:                stringOrEnv(cfg.CAFile, envCAFile),
+		CAPEM:                 cfg.CAPEM.ValueString(),
+		ClientCert:            cfg.ClientCert.ValueString(),
+		ClientKey:             cfg.ClientKey.ValueString(),
+		ProxyURL:              kkp.TrimmedStringValue(cfg.ProxyURL),
+		ExtraHeaders:          extraHeaders,
+		Timeout:               timeout,
+		UserAgent:             "terraform-provider-kkp/" + p.version,
+		MaxRetries:            int(maxRetries),
+		RetryMaxWait:          retryMaxWait,
+		RequestsPerSecond:     cfg.RequestsPerSecond.ValueFloat64(),
+		MaxConcurrentRequests: int(cfg.MaxConcurrent.ValueInt64()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("KKP client initialization failed", err.Error())
@@ -156,6 +408,16 @@ func (p *KKPProvider) Resources(_ context.Context) []func() resource.Resource {
 		resource_machine_deployment_v2.New,
 		resource_addon_v2.New,
 		resource_application_v2.New,
+		resource_cluster_readiness_v2.New,
+		resource_project_v2.New,
+		resource_project_member_v2.New,
+		resource_project_group_binding_v2.New,
+		resource_service_account_v2.New,
+		resource_service_account_token_v2.New,
+		resource_preset_v2.New,
+		resource_cluster_template_v2.New,
+		resource_cluster_template_instance_v2.New,
+		resource_cluster_ssh_key_attachment_v2.New,
 	}
 }
 
@@ -165,9 +427,54 @@ func (p *KKPProvider) DataSources(_ context.Context) []func() datasource.DataSou
 		data_source_addon_v2.NewDataSource,
 		data_source_application_v2.NewDataSource,
 		data_source_cluster_v2.NewDataSource,
+		data_source_cluster_v2.NewSingularDataSource,
 		data_source_cluster_kubeconfig_v2.NewDataSource,
 		data_source_machine_deployment_v2.NewDataSource,
+		data_source_machine_deployment_v2.NewSingularDataSource,
 		data_source_ssh_key_v2.NewDataSource,
+		data_source_ssh_key_v2.NewSingularDataSource,
 		data_source_cluster_template_v2.NewDataSource,
+		data_source_cluster_health_v2.NewDataSource,
+		data_source_project_v2.NewDataSource,
+		data_source_project_member_v2.NewDataSource,
+		data_source_preset_v2.NewDataSource,
+	}
+}
+
+// parseDurationAttribute parses an optional Go duration attribute, returning def when it is
+// unset. It adds an attribute error and returns false when the value is not a positive duration.
+func parseDurationAttribute(diags *diag.Diagnostics, v tftypes.String, name string, def time.Duration) (time.Duration, bool) {
+	raw := kkp.TrimmedStringValue(v)
+	if raw == "" {
+		return def, true
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(path.Root(name), "Invalid "+name, "Expected a positive Go duration such as 30s or 1m, got "+strconv.Quote(raw)+".")
+		return 0, false
+	}
+	return d, true
+}
+
+// buildOIDCConfig converts the oidc block, returning nil when the block is absent.
+func buildOIDCConfig(ctx context.Context, block *oidcConfig, diags *diag.Diagnostics) *kkp.OIDCConfig {
+	if block == nil {
+		return nil
+	}
+	oidc := &kkp.OIDCConfig{
+		IssuerURL:    kkp.TrimmedStringValue(block.IssuerURL),
+		ClientID:     kkp.TrimmedStringValue(block.ClientID),
+		ClientSecret: block.ClientSecret.ValueString(),
+		RefreshToken: block.RefreshToken.ValueString(),
+	}
+	if oidc.IssuerURL == "" {
+		diags.AddAttributeError(path.Root("oidc").AtName("issuer_url"), "Missing OIDC issuer", "'issuer_url' is required in the oidc block.")
+	}
+	if oidc.ClientID == "" {
+		diags.AddAttributeError(path.Root("oidc").AtName("client_id"), "Missing OIDC client ID", "'client_id' is required in the oidc block.")
+	}
+	if !block.Scopes.IsNull() && !block.Scopes.IsUnknown() {
+		diags.Append(block.Scopes.ElementsAs(ctx, &oidc.Scopes, false)...)
 	}
+	return oidc
 }
@@ -2,7 +2,6 @@ package addon_v2
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -27,6 +26,7 @@ var (
 	_ resource.Resource                = &resourceAddon{}
 	_ resource.ResourceWithConfigure   = &resourceAddon{}
 	_ resource.ResourceWithImportState = &resourceAddon{}
+	_ resource.ResourceWithModifyPlan  = &resourceAddon{}
 )
 
 // New creates a new addon v2 resource.
@@ -47,6 +47,7 @@ func (r *resourceAddon) Schema(_ context.Context, _ resource.SchemaRequest, resp
 func (r *resourceAddon) buildSchemaAttributes() map[string]rschema.Attribute {
 	return map[string]rschema.Attribute{
 		"id":                     r.buildIDAttribute(),
+		"project_id":             kkp.ProjectIDResourceAttribute(),
 		"cluster_id":             r.buildClusterIDAttribute(),
 		"name":                   r.buildNameAttribute(),
 		"continuously_reconcile": r.buildContinuouslyReconcileAttribute(),
@@ -174,6 +175,10 @@ func (r *resourceAddon) Configure(_ context.Context, req resource.ConfigureReque
 	r.ConfigureResource(req, resp)
 }
 
+func (r *resourceAddon) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
 func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.ValidateResourceBase(resp) {
 		return
@@ -184,6 +189,7 @@ func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	projectID := r.ProjectID(plan.ProjectID)
 	cp := Plan{
 		Name:                  plan.Name.ValueString(),
 		ClusterID:             plan.ClusterID.ValueString(),
@@ -210,29 +216,27 @@ func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	aclient := acli.New(r.Client.Transport, nil)
-	params := acli.NewCreateAddonV2Params().
-		WithProjectID(r.DefaultProjectID).
+	aclient := r.Client.Addons
+	params := acli.NewCreateAddonV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(cp.ClusterID).
 		WithBody(addon)
 
 	out, err := aclient.CreateAddonV2(params, nil)
 	if err != nil {
-		if e, ok := err.(*acli.CreateAddonV2Default); ok && e.Payload != nil {
-			b, _ := json.MarshalIndent(e.Payload, "", "  ")
-			resp.Diagnostics.AddError("Create addon failed", string(b))
-		} else if _, ok := err.(*acli.CreateAddonV2Unauthorized); ok {
+		switch {
+		case kkp.IsUnauthorized(err):
 			resp.Diagnostics.AddError(
 				"Create addon failed - Unauthorized",
-				fmt.Sprintf("Failed to create addon '%s'. This could be due to: 1) Invalid API token, 2) Insufficient permissions, 3) Addon name '%s' does not exist. Check available addons with 'data.kkp_addons_v2' and verify your API token has addon management permissions.", cp.Name, cp.Name),
+				fmt.Sprintf("Failed to create addon '%s'. KKP also answers 401 when the addon name is not installable on this cluster; check available addons with 'data.kkp_addons_v2' and verify your API token.\n\n%s", cp.Name, kkp.APIErrorDetail(err)),
 			)
-		} else if _, ok := err.(*acli.CreateAddonV2Forbidden); ok {
+		case kkp.IsForbidden(err):
 			resp.Diagnostics.AddError(
 				"Create addon failed - Forbidden",
-				fmt.Sprintf("Access denied when creating addon '%s'. Your API token may not have sufficient permissions for addon management on this cluster.", cp.Name),
+				fmt.Sprintf("Access denied when creating addon '%s'. Your API token may not have sufficient permissions for addon management on this cluster.\n\n%s", cp.Name, kkp.APIErrorDetail(err)),
 			)
-		} else {
-			resp.Diagnostics.AddError("Create addon failed", err.Error())
+		default:
+			kkp.AddAPIError(&resp.Diagnostics, "Create addon failed", err)
 		}
 		return
 	}
@@ -248,6 +252,7 @@ func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 	// Build initial state from response
 	state := plan
 	state.ID = tftypes.StringValue(addonID)
+	state.ProjectID = tftypes.StringValue(projectID)
 	state.Name = tftypes.StringValue(out.Payload.Name)
 
 	// Set defaults for optional fields
@@ -293,7 +298,7 @@ func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 			"wait_enabled":    waitForReady,
 		})
 
-		status, message := r.waitForAddonReady(ctx, cp.ClusterID, addonID, timeout)
+		status, message := r.waitForAddonReady(ctx, projectID, cp.ClusterID, addonID, timeout)
 		r.updateStatusFields(state, status, message)
 	} else {
 		tflog.Info(ctx, "Skipping addon readiness check (wait_for_ready=false)", map[string]any{
@@ -302,7 +307,7 @@ func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 		})
 
 		// Just do a quick status check without waiting
-		status, message := r.checkAddonStatus(ctx, cp.ClusterID, addonID)
+		status, message := r.checkAddonStatus(ctx, projectID, cp.ClusterID, addonID)
 		r.updateStatusFields(state, status, message)
 	}
 
@@ -324,14 +329,16 @@ func (r *resourceAddon) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	addon, err := r.fetchAddon(clusterID, id)
+	projectID := r.ProjectID(state.ProjectID)
+	addon, err := r.fetchAddon(ctx, projectID, clusterID, id)
 	if err != nil {
 		r.handleFetchAddonError(ctx, err, resp)
 		return
 	}
 
+	state.ProjectID = tftypes.StringValue(projectID)
 	r.updateStateFromAddon(state, addon)
-	r.refreshAddonStatus(ctx, state, clusterID, id)
+	r.refreshAddonStatus(ctx, state, projectID, clusterID, id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -348,10 +355,10 @@ func (r *resourceAddon) extractIdentifiers(state *addonState, resp *resource.Rea
 }
 
 // fetchAddon retrieves addon details from the API.
-func (r *resourceAddon) fetchAddon(clusterID, addonID string) (*acli.GetAddonV2OK, error) {
-	aclient := acli.New(r.Client.Transport, nil)
-	get := acli.NewGetAddonV2Params().
-		WithProjectID(r.DefaultProjectID).
+func (r *resourceAddon) fetchAddon(ctx context.Context, projectID, clusterID, addonID string) (*acli.GetAddonV2OK, error) {
+	aclient := r.Client.Addons
+	get := acli.NewGetAddonV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithAddonID(addonID)
 
@@ -360,11 +367,11 @@ func (r *resourceAddon) fetchAddon(clusterID, addonID string) (*acli.GetAddonV2O
 
 // handleFetchAddonError handles errors from fetching addon.
 func (r *resourceAddon) handleFetchAddonError(ctx context.Context, err error, resp *resource.ReadResponse) {
-	if strings.Contains(strings.ToLower(err.Error()), "not found") || strings.Contains(err.Error(), "404") {
+	if kkp.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	resp.Diagnostics.AddError("Read addon failed", err.Error())
+	kkp.AddAPIError(&resp.Diagnostics, "Read addon failed", err)
 }
 
 // updateStateFromAddon updates state with addon details from API response.
@@ -392,8 +399,8 @@ func (r *resourceAddon) updateStateFromAddon(state *addonState, addon *acli.GetA
 }
 
 // refreshAddonStatus checks and updates current addon status.
-func (r *resourceAddon) refreshAddonStatus(ctx context.Context, state *addonState, clusterID, addonID string) {
-	status, message := r.checkAddonStatus(ctx, clusterID, addonID)
+func (r *resourceAddon) refreshAddonStatus(ctx context.Context, state *addonState, projectID, clusterID, addonID string) {
+	status, message := r.checkAddonStatus(ctx, projectID, clusterID, addonID)
 	r.updateStatusFields(state, status, message)
 
 	tflog.Debug(ctx, "addon status refreshed", map[string]any{
@@ -426,6 +433,7 @@ func (r *resourceAddon) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddError("Missing identifiers", "State missing addon or cluster ID.")
 		return
 	}
+	projectID := r.ProjectID(state.ProjectID)
 
 	// Build patch body for changes
 	patch := map[string]any{}
@@ -492,17 +500,17 @@ func (r *resourceAddon) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	aclient := acli.New(r.Client.Transport, nil)
+	aclient := r.Client.Addons
 	_, err := aclient.PatchAddonV2(
-		acli.NewPatchAddonV2Params().
-			WithProjectID(r.DefaultProjectID).
+		acli.NewPatchAddonV2Params().WithContext(ctx).
+			WithProjectID(projectID).
 			WithClusterID(clusterID).
 			WithAddonID(id).
 			WithBody(patchAddon),
 		nil,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Patch addon failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Patch addon failed", err)
 		return
 	}
 
@@ -513,14 +521,14 @@ func (r *resourceAddon) Update(ctx context.Context, req resource.UpdateRequest,
 	})
 
 	// Read updated addon to get current values
-	get := acli.NewGetAddonV2Params().
-		WithProjectID(r.DefaultProjectID).
+	get := acli.NewGetAddonV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithAddonID(id)
 
 	got, err := aclient.GetAddonV2(get, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Read addon after update failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Read addon after update failed", err)
 		return
 	}
 
@@ -528,6 +536,7 @@ func (r *resourceAddon) Update(ctx context.Context, req resource.UpdateRequest,
 	finalState := plan
 	finalState.ID = state.ID
 	finalState.ClusterID = state.ClusterID
+	finalState.ProjectID = tftypes.StringValue(projectID)
 	finalState.Name = tftypes.StringValue(got.Payload.Name)
 
 	// Preserve creation timestamp from previous state
@@ -549,7 +558,7 @@ func (r *resourceAddon) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Check and update current status after update
-	status, message := r.checkAddonStatus(ctx, clusterID, id)
+	status, message := r.checkAddonStatus(ctx, projectID, clusterID, id)
 	r.updateStatusFields(finalState, status, message)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &finalState)...)
@@ -571,24 +580,28 @@ func (r *resourceAddon) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	aclient := acli.New(r.Client.Transport, nil)
-	del := acli.NewDeleteAddonV2Params().
-		WithProjectID(r.DefaultProjectID).
+	aclient := r.Client.Addons
+	del := acli.NewDeleteAddonV2Params().WithContext(ctx).
+		WithProjectID(r.ProjectID(state.ProjectID)).
 		WithClusterID(clusterID).
 		WithAddonID(id)
 
-	if _, err := aclient.DeleteAddonV2(del, nil); err != nil {
-		resp.Diagnostics.AddWarning("Delete addon warning", err.Error())
+	if _, err := aclient.DeleteAddonV2(del, nil); err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIWarning(&resp.Diagnostics, "Delete addon warning", err)
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
 func (r *resourceAddon) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: cluster_id:addon_id
-	parts := strings.Split(req.ID, ":")
+	// Import format: [project_id:]cluster_id:addon_id
+	id := kkp.ImportProjectID(ctx, req, resp, 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parts := strings.Split(id, ":")
 	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Unexpected import ID", "Expected 'cluster_id:addon_id'")
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected 'cluster_id:addon_id' or 'project_id:cluster_id:addon_id'")
 		return
 	}
 
@@ -604,16 +617,16 @@ func (r *resourceAddon) ImportState(ctx context.Context, req resource.ImportStat
 }
 
 // Helper function to check addon status
-func (r *resourceAddon) checkAddonStatus(_ context.Context, clusterID, addonID string) (status, message string) {
-	aclient := acli.New(r.Client.Transport, nil)
-	get := acli.NewGetAddonV2Params().
-		WithProjectID(r.DefaultProjectID).
+func (r *resourceAddon) checkAddonStatus(ctx context.Context, projectID, clusterID, addonID string) (status, message string) {
+	aclient := r.Client.Addons
+	get := acli.NewGetAddonV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithAddonID(addonID)
 
 	got, err := aclient.GetAddonV2(get, nil)
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "not found") || strings.Contains(err.Error(), "404") {
+		if kkp.IsNotFound(err) {
 			return kkp.StatusFailed, "Addon not found - installation may have failed"
 		}
 		return kkp.StatusFailed, fmt.Sprintf("Error checking status: %s", err.Error())
@@ -629,7 +642,7 @@ func (r *resourceAddon) checkAddonStatus(_ context.Context, clusterID, addonID s
 }
 
 // Helper function to wait for addon installation with polling
-func (r *resourceAddon) waitForAddonReady(ctx context.Context, clusterID, addonID string, maxWaitTime time.Duration) (status, message string) {
+func (r *resourceAddon) waitForAddonReady(ctx context.Context, projectID, clusterID, addonID string, maxWaitTime time.Duration) (status, message string) {
 	deadline := time.Now().Add(maxWaitTime)
 	pollInterval := 10 * time.Second
 	maxAttempts := int(maxWaitTime / pollInterval)
@@ -637,7 +650,7 @@ func (r *resourceAddon) waitForAddonReady(ctx context.Context, clusterID, addonI
 	r.logWaitStart(ctx, clusterID, addonID, maxWaitTime, pollInterval, maxAttempts)
 
 	for attempt := 1; time.Now().Before(deadline); attempt++ {
-		status, msg := r.checkAddonStatus(ctx, clusterID, addonID)
+		status, msg := r.checkAddonStatus(ctx, projectID, clusterID, addonID)
 
 		r.logStatusCheck(ctx, clusterID, addonID, attempt, maxAttempts, status, msg, deadline.Add(-maxWaitTime))
 
@@ -29,6 +29,7 @@ type resourceAddon struct {
 
 type addonState struct {
 	ID                    tftypes.String `tfsdk:"id"`
+	ProjectID             tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
 	ClusterID             tftypes.String `tfsdk:"cluster_id"`
 	Name                  tftypes.String `tfsdk:"name"`
 	ContinuouslyReconcile tftypes.Bool   `tfsdk:"continuously_reconcile"`
@@ -29,6 +29,7 @@ var (
 	_ resource.Resource                = &resourceApplication{}
 	_ resource.ResourceWithConfigure   = &resourceApplication{}
 	_ resource.ResourceWithImportState = &resourceApplication{}
+	_ resource.ResourceWithModifyPlan  = &resourceApplication{}
 )
 
 const (
@@ -54,6 +55,7 @@ func (r *resourceApplication) Schema(_ context.Context, _ resource.SchemaRequest
 func (r *resourceApplication) buildSchemaAttributes() map[string]rschema.Attribute {
 	return map[string]rschema.Attribute{
 		"id":                  r.buildIDAttribute(),
+		"project_id":          kkp.ProjectIDResourceAttribute(),
 		"cluster_id":          r.buildClusterIDAttribute(),
 		"name":                r.buildNameAttribute(),
 		"namespace":           r.buildNamespaceAttribute(),
@@ -210,6 +212,10 @@ func (r *resourceApplication) Configure(_ context.Context, req resource.Configur
 	r.ConfigureResource(req, resp)
 }
 
+func (r *resourceApplication) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
 func (r *resourceApplication) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.ValidateResourceBase(resp) {
 		return
@@ -220,6 +226,7 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	projectID := r.ProjectID(plan.ProjectID)
 	cp := Plan{
 		Name:               plan.Name.ValueString(),
 		ClusterID:          plan.ClusterID.ValueString(),
@@ -243,9 +250,9 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	aclient := acli.New(r.Client.Transport, nil)
-	params := acli.NewCreateApplicationInstallationParams().
-		WithProjectID(r.DefaultProjectID).
+	aclient := r.Client.Applications
+	params := acli.NewCreateApplicationInstallationParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(cp.ClusterID).
 		WithBody(&models.ApplicationInstallationBody{
 			Name:      appInstallation.Name,
@@ -255,12 +262,7 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 
 	out, err := aclient.CreateApplicationInstallation(params, nil)
 	if err != nil {
-		if e, ok := err.(*acli.CreateApplicationInstallationDefault); ok && e.Payload != nil {
-			b, _ := json.MarshalIndent(e.Payload, "", "  ")
-			resp.Diagnostics.AddError("Create application failed", string(b))
-		} else {
-			resp.Diagnostics.AddError("Create application failed", err.Error())
-		}
+		kkp.AddAPIError(&resp.Diagnostics, "Create application failed", err)
 		return
 	}
 
@@ -280,6 +282,7 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 	// Build initial state from response
 	state := plan
 	state.ID = tftypes.StringValue(appID)
+	state.ProjectID = tftypes.StringValue(projectID)
 	state.Name = tftypes.StringValue(out.Payload.Name)
 	state.Namespace = tftypes.StringValue(out.Payload.Namespace)
 	state.Values = plan.Values
@@ -339,7 +342,7 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 			"wait_enabled":    waitForReady,
 		})
 
-		status, message := r.waitForApplicationReady(ctx, cp.ClusterID, cp.Namespace, cp.Name, timeout)
+		status, message := r.waitForApplicationReady(ctx, projectID, cp.ClusterID, cp.Namespace, cp.Name, timeout)
 		r.updateStatusFields(state, status, message)
 	} else {
 		tflog.Info(ctx, "Skipping application readiness check (wait_for_ready=false)", map[string]any{
@@ -348,7 +351,7 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 		})
 
 		// Just do a quick status check without waiting
-		status, message := r.checkApplicationStatus(ctx, cp.ClusterID, cp.Namespace, cp.Name)
+		status, message := r.checkApplicationStatus(ctx, projectID, cp.ClusterID, cp.Namespace, cp.Name)
 		r.updateStatusFields(state, status, message)
 	}
 
@@ -370,14 +373,16 @@ func (r *resourceApplication) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	application, err := r.fetchApplication(clusterID, namespace, name)
+	projectID := r.ProjectID(state.ProjectID)
+	application, err := r.fetchApplication(ctx, projectID, clusterID, namespace, name)
 	if err != nil {
 		r.handleFetchApplicationError(ctx, err, resp)
 		return
 	}
 
+	state.ProjectID = tftypes.StringValue(projectID)
 	r.updateStateFromApplication(state, application, clusterID, namespace)
-	r.refreshApplicationStatus(ctx, state, clusterID, namespace, name)
+	r.refreshApplicationStatus(ctx, state, projectID, clusterID, namespace, name)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -400,10 +405,10 @@ func (r *resourceApplication) extractApplicationIdentifiers(state *applicationSt
 }
 
 // fetchApplication retrieves application installation details from the API.
-func (r *resourceApplication) fetchApplication(clusterID, namespace, name string) (*acli.GetApplicationInstallationOK, error) {
-	aclient := acli.New(r.Client.Transport, nil)
-	get := acli.NewGetApplicationInstallationParams().
-		WithProjectID(r.DefaultProjectID).
+func (r *resourceApplication) fetchApplication(ctx context.Context, projectID, clusterID, namespace, name string) (*acli.GetApplicationInstallationOK, error) {
+	aclient := r.Client.Applications
+	get := acli.NewGetApplicationInstallationParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithNamespace(namespace).
 		WithApplicationInstallationName(name)
@@ -413,11 +418,11 @@ func (r *resourceApplication) fetchApplication(clusterID, namespace, name string
 
 // handleFetchApplicationError handles errors from fetching application.
 func (r *resourceApplication) handleFetchApplicationError(ctx context.Context, err error, resp *resource.ReadResponse) {
-	if strings.Contains(strings.ToLower(err.Error()), "not found") || strings.Contains(err.Error(), "404") {
+	if kkp.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	resp.Diagnostics.AddError("Read application failed", err.Error())
+	kkp.AddAPIError(&resp.Diagnostics, "Read application failed", err)
 }
 
 // updateStateFromApplication updates state with application details from API response.
@@ -453,8 +458,8 @@ func (r *resourceApplication) updateStateFromApplication(state *applicationState
 }
 
 // refreshApplicationStatus checks and updates current application status.
-func (r *resourceApplication) refreshApplicationStatus(ctx context.Context, state *applicationState, clusterID, namespace, name string) {
-	status, message := r.checkApplicationStatus(ctx, clusterID, namespace, name)
+func (r *resourceApplication) refreshApplicationStatus(ctx context.Context, state *applicationState, projectID, clusterID, namespace, name string) {
+	status, message := r.checkApplicationStatus(ctx, projectID, clusterID, namespace, name)
 	r.updateStatusFields(state, status, message)
 
 	tflog.Debug(ctx, "application status refreshed", map[string]any{
@@ -494,6 +499,7 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 	if id == "" {
 		id = r.fallbackApplicationID(clusterID, namespace, name)
 	}
+	projectID := r.ProjectID(state.ProjectID)
 
 	// Build update body from plan
 	updateBody := &models.ApplicationInstallationBody{
@@ -531,10 +537,10 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 
 	updateBody.Spec = spec
 
-	aclient := acli.New(r.Client.Transport, nil)
+	aclient := r.Client.Applications
 	_, err = aclient.UpdateApplicationInstallation(
-		acli.NewUpdateApplicationInstallationParams().
-			WithProjectID(r.DefaultProjectID).
+		acli.NewUpdateApplicationInstallationParams().WithContext(ctx).
+			WithProjectID(projectID).
 			WithClusterID(clusterID).
 			WithNamespace(namespace).
 			WithApplicationInstallationName(name).
@@ -542,7 +548,7 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 		nil,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Update application failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Update application failed", err)
 		return
 	}
 
@@ -554,8 +560,8 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 	})
 
 	// Read updated application to get current values
-	get := acli.NewGetApplicationInstallationParams().
-		WithProjectID(r.DefaultProjectID).
+	get := acli.NewGetApplicationInstallationParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithNamespace(namespace).
 		WithApplicationInstallationName(name)
@@ -563,7 +569,7 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 	var got *acli.GetApplicationInstallationOK
 	got, err = aclient.GetApplicationInstallation(get, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Read application after update failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Read application after update failed", err)
 		return
 	}
 
@@ -575,6 +581,7 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 	}
 	finalState.ID = tftypes.StringValue(currentID)
 	finalState.ClusterID = state.ClusterID
+	finalState.ProjectID = tftypes.StringValue(projectID)
 	finalState.Name = tftypes.StringValue(got.Payload.Name)
 	finalState.Namespace = tftypes.StringValue(got.Payload.Namespace)
 	finalState.Values = plan.Values
@@ -611,7 +618,7 @@ func (r *resourceApplication) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Check and update current status after update
-	status, message := r.checkApplicationStatus(ctx, clusterID, namespace, name)
+	status, message := r.checkApplicationStatus(ctx, projectID, clusterID, namespace, name)
 	r.updateStatusFields(finalState, status, message)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &finalState)...)
@@ -634,25 +641,29 @@ func (r *resourceApplication) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	aclient := acli.New(r.Client.Transport, nil)
-	del := acli.NewDeleteApplicationInstallationParams().
-		WithProjectID(r.DefaultProjectID).
+	aclient := r.Client.Applications
+	del := acli.NewDeleteApplicationInstallationParams().WithContext(ctx).
+		WithProjectID(r.ProjectID(state.ProjectID)).
 		WithClusterID(clusterID).
 		WithNamespace(namespace).
 		WithApplicationInstallationName(name)
 
-	if _, err := aclient.DeleteApplicationInstallation(del, nil); err != nil {
-		resp.Diagnostics.AddWarning("Delete application warning", err.Error())
+	if _, err := aclient.DeleteApplicationInstallation(del, nil); err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIWarning(&resp.Diagnostics, "Delete application warning", err)
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
 func (r *resourceApplication) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: cluster_id:namespace:name
-	parts := strings.Split(req.ID, ":")
+	// Import format: [project_id:]cluster_id:namespace:name
+	id := kkp.ImportProjectID(ctx, req, resp, 3)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parts := strings.Split(id, ":")
 	if len(parts) != 3 {
-		resp.Diagnostics.AddError("Unexpected import ID", "Expected 'cluster_id:namespace:name'")
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected 'cluster_id:namespace:name' or 'project_id:cluster_id:namespace:name'")
 		return
 	}
 
@@ -670,17 +681,17 @@ func (r *resourceApplication) ImportState(ctx context.Context, req resource.Impo
 }
 
 // Helper function to check application status
-func (r *resourceApplication) checkApplicationStatus(_ context.Context, clusterID, namespace, name string) (status, message string) {
-	aclient := acli.New(r.Client.Transport, nil)
-	get := acli.NewGetApplicationInstallationParams().
-		WithProjectID(r.DefaultProjectID).
+func (r *resourceApplication) checkApplicationStatus(ctx context.Context, projectID, clusterID, namespace, name string) (status, message string) {
+	aclient := r.Client.Applications
+	get := acli.NewGetApplicationInstallationParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithNamespace(namespace).
 		WithApplicationInstallationName(name)
 
 	got, err := aclient.GetApplicationInstallation(get, nil)
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "not found") || strings.Contains(err.Error(), "404") {
+		if kkp.IsNotFound(err) {
 			return kkp.StatusFailed, "Application not found - installation may have failed"
 		}
 		return kkp.StatusFailed, fmt.Sprintf("Error checking status: %s", err.Error())
@@ -712,7 +723,7 @@ func (r *resourceApplication) checkApplicationStatus(_ context.Context, clusterI
 }
 
 // Helper function to wait for application installation with polling
-func (r *resourceApplication) waitForApplicationReady(ctx context.Context, clusterID, namespace, name string, maxWaitTime time.Duration) (status, message string) {
+func (r *resourceApplication) waitForApplicationReady(ctx context.Context, projectID, clusterID, namespace, name string, maxWaitTime time.Duration) (status, message string) {
 	deadline := time.Now().Add(maxWaitTime)
 	pollInterval := 15 * time.Second // Longer interval for applications
 	maxAttempts := int(maxWaitTime / pollInterval)
@@ -727,7 +738,7 @@ func (r *resourceApplication) waitForApplicationReady(ctx context.Context, clust
 	})
 
 	for attempt := 1; time.Now().Before(deadline); attempt++ {
-		status, msg := r.checkApplicationStatus(ctx, clusterID, namespace, name)
+		status, msg := r.checkApplicationStatus(ctx, projectID, clusterID, namespace, name)
 
 		// Log progress every 60 seconds (every 4th attempt)
 		if attempt == 1 || attempt%4 == 0 || status == kkp.StatusReady || status == kkp.StatusFailed {
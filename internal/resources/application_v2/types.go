@@ -32,6 +32,7 @@ type resourceApplication struct {
 
 type applicationState struct {
 	ID        tftypes.String `tfsdk:"id"`
+	ProjectID tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
 	ClusterID tftypes.String `tfsdk:"cluster_id"`
 	Name      tftypes.String `tfsdk:"name"`
 	Namespace tftypes.String `tfsdk:"namespace"`
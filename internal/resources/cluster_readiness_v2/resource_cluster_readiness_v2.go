@@ -0,0 +1,252 @@
+// Package cluster_readiness_v2 implements a Terraform resource that waits for a KKP cluster to become healthy.
+package cluster_readiness_v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+)
+
+var (
+	_ resource.Resource                = &resourceClusterReadiness{}
+	_ resource.ResourceWithConfigure   = &resourceClusterReadiness{}
+	_ resource.ResourceWithImportState = &resourceClusterReadiness{}
+	_ resource.ResourceWithModifyPlan  = &resourceClusterReadiness{}
+)
+
+const defaultTimeoutMinutes = int64(15)
+
+// New creates a new cluster readiness v2 resource.
+func New() resource.Resource { return &resourceClusterReadiness{} }
+
+func (r *resourceClusterReadiness) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_readiness_v2"
+}
+
+func (r *resourceClusterReadiness) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Waits for an existing KKP cluster to become healthy. Use together with `wait_for_ready = false` on kkp_cluster_v2 to create clusters in parallel and gate only the dependents that need a ready control plane.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Resource identifier (same as cluster_id).",
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"cluster_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "Cluster ID to wait for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout_minutes": rschema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Timeout in minutes for waiting for the cluster to become healthy. Defaults to 15 minutes.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ready": rschema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the cluster was reported healthy.",
+			},
+			"api_url": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Cluster API server URL.",
+			},
+			"last_checked": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Last time readiness was checked (RFC3339 timestamp).",
+			},
+		},
+	}
+}
+
+func (r *resourceClusterReadiness) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceClusterReadiness) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
+func (r *resourceClusterReadiness) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[clusterReadinessState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	clusterID := kkp.TrimmedStringValue(plan.ClusterID)
+	if clusterID == "" {
+		resp.Diagnostics.AddError("Missing cluster_id", "cluster_id is required to wait for cluster readiness")
+		return
+	}
+	timeoutMinutes := kkp.Int64ValueOrDefault(plan.TimeoutMinutes, defaultTimeoutMinutes)
+	projectID := r.ProjectID(plan.ProjectID)
+
+	tflog.Info(ctx, "waiting for cluster readiness", map[string]any{
+		"cluster_id":      clusterID,
+		"timeout_minutes": timeoutMinutes,
+	})
+
+	checker := &kkp.ClusterHealthChecker{
+		Client:    r.Client,
+		ProjectID: projectID,
+		ClusterID: clusterID,
+	}
+	if err := checker.WaitForClusterReadyWithTimeout(ctx, 10*time.Second, time.Duration(timeoutMinutes)*time.Minute); err != nil {
+		resp.Diagnostics.AddError(
+			"Cluster did not become ready",
+			fmt.Sprintf("cluster %q: %s", clusterID, err.Error()),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = tftypes.StringValue(clusterID)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.ClusterID = tftypes.StringValue(clusterID)
+	state.TimeoutMinutes = tftypes.Int64Value(timeoutMinutes)
+	state.Ready = tftypes.BoolValue(true)
+	state.APIURL = tftypes.StringValue(r.fetchAPIURL(ctx, projectID, clusterID))
+	state.LastChecked = tftypes.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusterReadiness) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[clusterReadinessState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	clusterID := kkp.TrimmedStringValue(state.ClusterID)
+	if clusterID == "" {
+		clusterID = kkp.TrimmedStringValue(state.ID)
+	}
+	if clusterID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	pcli := r.Client.Project
+	got, err := pcli.GetClusterV2(
+		kapi.NewGetClusterV2Params().WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterID(clusterID),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Read cluster failed", err)
+		return
+	}
+
+	// Readiness is a one-shot gate recorded at creation; only refresh informational fields here.
+	state.ID = tftypes.StringValue(clusterID)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.ClusterID = tftypes.StringValue(clusterID)
+	state.TimeoutMinutes = tftypes.Int64Value(kkp.Int64ValueOrDefault(state.TimeoutMinutes, defaultTimeoutMinutes))
+	state.Ready = tftypes.BoolValue(kkp.BoolValueOrDefault(state.Ready, true))
+	if got != nil && got.Payload != nil && got.Payload.Status != nil {
+		state.APIURL = tftypes.StringValue(strings.TrimSpace(got.Payload.Status.URL))
+	} else if state.APIURL.IsNull() || state.APIURL.IsUnknown() {
+		state.APIURL = tftypes.StringValue("")
+	}
+	if state.LastChecked.IsNull() || state.LastChecked.IsUnknown() {
+		state.LastChecked = tftypes.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusterReadiness) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, ok := kkp.ExtractStateForUpdate[clusterReadinessState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state clusterReadinessState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only timeout_minutes can change in place; it has no effect on an already-ready cluster.
+	plan.ID = state.ID
+	plan.ProjectID = tftypes.StringValue(r.ProjectID(plan.ProjectID))
+	plan.TimeoutMinutes = tftypes.Int64Value(kkp.Int64ValueOrDefault(plan.TimeoutMinutes, defaultTimeoutMinutes))
+	plan.Ready = state.Ready
+	plan.APIURL = state.APIURL
+	plan.LastChecked = state.LastChecked
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceClusterReadiness) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Nothing to delete server-side; the readiness gate only lives in state.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceClusterReadiness) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<cluster_id>' or '<project_id>:<cluster_id>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), id)...)
+}
+
+// fetchAPIURL returns the cluster API server URL, or an empty string when unavailable.
+func (r *resourceClusterReadiness) fetchAPIURL(ctx context.Context, projectID, clusterID string) string {
+	pcli := r.Client.Project
+	got, err := pcli.GetClusterV2(
+		kapi.NewGetClusterV2Params().WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterID(clusterID),
+		nil,
+	)
+	if err != nil {
+		tflog.Debug(ctx, "get cluster failed after readiness wait", map[string]any{
+			"cluster_id": clusterID,
+			"error":      err.Error(),
+		})
+		return ""
+	}
+	if got == nil || got.Payload == nil || got.Payload.Status == nil {
+		return ""
+	}
+	return strings.TrimSpace(got.Payload.Status.URL)
+}
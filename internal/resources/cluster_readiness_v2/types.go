@@ -0,0 +1,27 @@
+package cluster_readiness_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceClusterReadiness struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type clusterReadinessState struct {
+	ID             tftypes.String `tfsdk:"id"`
+	ProjectID      tftypes.String `tfsdk:"project_id"`
+	ClusterID      tftypes.String `tfsdk:"cluster_id"`
+	TimeoutMinutes tftypes.Int64  `tfsdk:"timeout_minutes"` // Timeout for waiting (default: 15 minutes)
+
+	// Status tracking fields
+	Ready       tftypes.Bool   `tfsdk:"ready"`        // Cluster reported healthy
+	APIURL      tftypes.String `tfsdk:"api_url"`      // Cluster API server URL
+	LastChecked tftypes.String `tfsdk:"last_checked"` // Last time readiness was checked (RFC3339)
+}
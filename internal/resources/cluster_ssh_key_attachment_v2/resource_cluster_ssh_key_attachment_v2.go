@@ -0,0 +1,194 @@
+// Package cluster_ssh_key_attachment_v2 implements the Terraform resource for single SSH key assignments on KKP clusters.
+package cluster_ssh_key_attachment_v2
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+var (
+	_ resource.Resource                = &resourceClusterSSHKeyAttachment{}
+	_ resource.ResourceWithConfigure   = &resourceClusterSSHKeyAttachment{}
+	_ resource.ResourceWithImportState = &resourceClusterSSHKeyAttachment{}
+	_ resource.ResourceWithModifyPlan  = &resourceClusterSSHKeyAttachment{}
+)
+
+// New creates a new cluster SSH key attachment v2 resource.
+func New() resource.Resource { return &resourceClusterSSHKeyAttachment{} }
+
+func (r *resourceClusterSSHKeyAttachment) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_ssh_key_attachment_v2"
+}
+
+func (r *resourceClusterSSHKeyAttachment) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Assigns a single project SSH key to a KKP cluster, leaving other keys on the cluster alone. Can be combined with ssh_key_ids on kkp_cluster_v2, which only manages the keys in its own list. Uses the provider-level project_id unless project_id is set.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Attachment ID in the form <cluster_id>:<key_id>.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"cluster_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "Cluster ID to assign the key to.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "ID of the project SSH key to assign.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceClusterSSHKeyAttachment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceClusterSSHKeyAttachment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
+func (r *resourceClusterSSHKeyAttachment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[attachmentState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	clusterID := kkp.TrimmedStringValue(plan.ClusterID)
+	keyID := kkp.TrimmedStringValue(plan.KeyID)
+
+	// Assigning a key that is already on the cluster is not an error; the attachment
+	// simply takes over managing it.
+	current, err := kkp.ClusterSSHKeyIDs(ctx, r.Client.Project, projectID, clusterID)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "List cluster SSH keys failed", err)
+		return
+	}
+	if !slices.Contains(current, keyID) {
+		if err := kkp.AssignClusterSSHKey(ctx, r.Client.Project, projectID, clusterID, keyID); err != nil {
+			kkp.AddAPIError(&resp.Diagnostics, "Assign SSH key to cluster failed", err)
+			return
+		}
+	}
+
+	state := *plan
+	state.ID = tftypes.StringValue(clusterID + ":" + keyID)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.ClusterID = tftypes.StringValue(clusterID)
+	state.KeyID = tftypes.StringValue(keyID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceClusterSSHKeyAttachment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[attachmentState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	clusterID := kkp.TrimmedStringValue(state.ClusterID)
+	keyID := kkp.TrimmedStringValue(state.KeyID)
+	if clusterID == "" || keyID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain cluster_id and key_id.")
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	current, err := kkp.ClusterSSHKeyIDs(ctx, r.Client.Project, projectID, clusterID)
+	if err != nil {
+		if kkp.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "List cluster SSH keys failed", err)
+		return
+	}
+	if !slices.Contains(current, keyID) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = tftypes.StringValue(clusterID + ":" + keyID)
+	state.ProjectID = tftypes.StringValue(projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusterSSHKeyAttachment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute forces replacement; nothing to update in place.
+	plan, ok := kkp.ExtractStateForUpdate[attachmentState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceClusterSSHKeyAttachment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[attachmentState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	clusterID := kkp.TrimmedStringValue(state.ClusterID)
+	keyID := kkp.TrimmedStringValue(state.KeyID)
+	if clusterID == "" || keyID == "" {
+		return
+	}
+
+	err := kkp.DetachClusterSSHKey(ctx, r.Client.Project, r.ProjectID(state.ProjectID), clusterID, keyID)
+	if err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIError(&resp.Diagnostics, "Detach SSH key from cluster failed", err)
+	}
+}
+
+func (r *resourceClusterSSHKeyAttachment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := kkp.ImportProjectID(ctx, req, resp, 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterID, keyID, ok := strings.Cut(id, ":")
+	if !ok || clusterID == "" || keyID == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<cluster_id>:<key_id>' or '<project_id>:<cluster_id>:<key_id>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_id"), keyID)...)
+}
@@ -0,0 +1,22 @@
+package cluster_ssh_key_attachment_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceClusterSSHKeyAttachment struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type attachmentState struct {
+	ID        tftypes.String `tfsdk:"id"`         // computed: <cluster_id>:<key_id>
+	ProjectID tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
+	ClusterID tftypes.String `tfsdk:"cluster_id"` // required
+	KeyID     tftypes.String `tfsdk:"key_id"`     // required
+}
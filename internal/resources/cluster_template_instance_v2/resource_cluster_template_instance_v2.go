@@ -0,0 +1,396 @@
+// Package cluster_template_instance_v2 implements the Terraform resource for KKP cluster template instances.
+package cluster_template_instance_v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+)
+
+var (
+	_ resource.Resource                = &resourceClusterTemplateInstance{}
+	_ resource.ResourceWithConfigure   = &resourceClusterTemplateInstance{}
+	_ resource.ResourceWithImportState = &resourceClusterTemplateInstance{}
+	_ resource.ResourceWithModifyPlan  = &resourceClusterTemplateInstance{}
+)
+
+// templateInstanceLabel is the label KKP puts on every cluster created from a template
+// instance; its value is the instance name.
+const templateInstanceLabel = "template-instance"
+
+// New creates a new cluster template instance v2 resource.
+func New() resource.Resource { return &resourceClusterTemplateInstance{} }
+
+func (r *resourceClusterTemplateInstance) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_template_instance_v2"
+}
+
+func (r *resourceClusterTemplateInstance) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Creates one or more clusters from a KKP cluster template and manages all of them. Destroying the resource deletes every cluster of the instance. Uses the provider-level project_id unless project_id is set.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Template instance name, as set in the template-instance label of its clusters.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"template_id": rschema.StringAttribute{
+				Optional:    true,
+				Description: "Cluster template ID to instantiate.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("template_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"template_name": rschema.StringAttribute{
+				Optional:    true,
+				Description: "Cluster template name to instantiate (alternative to template_id). Must be unique in the project.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"replicas": rschema.Int64Attribute{
+				Required:    true,
+				Description: "Number of clusters to create from the template. Changing it recreates all clusters.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, kkp.MaxReplicas),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_ready": rschema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Wait for every cluster to become healthy during creation. Defaults to true.",
+			},
+			"cluster_ids": rschema.ListAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "IDs of the clusters created from the template, sorted.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceClusterTemplateInstance) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceClusterTemplateInstance) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
+func (r *resourceClusterTemplateInstance) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[templateInstanceState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	templateID := kkp.TrimmedStringValue(plan.TemplateID)
+	if templateID == "" {
+		id, err := r.resolveTemplateID(ctx, projectID, kkp.TrimmedStringValue(plan.TemplateName))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to resolve template by name", err.Error())
+			return
+		}
+		templateID = id
+	}
+	replicas := plan.Replicas.ValueInt64()
+
+	out, err := r.Client.Project.CreateClusterTemplateInstance(
+		kapi.NewCreateClusterTemplateInstanceParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterTemplateID(templateID).
+			WithBody(kapi.CreateClusterTemplateInstanceBody{Replicas: replicas}),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Create cluster template instance failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil || out.Payload.Name == "" {
+		resp.Diagnostics.AddError("Create cluster template instance failed", "KKP returned no template instance name.")
+		return
+	}
+	instance := out.Payload.Name
+
+	tflog.Info(ctx, "cluster template instance created", map[string]any{
+		"project_id":  projectID,
+		"template_id": templateID,
+		"instance":    instance,
+		"replicas":    replicas,
+	})
+
+	// Persist the instance right away so the clusters are not orphaned if waiting fails.
+	state := *plan
+	state.ID = tftypes.StringValue(instance)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.WaitForReady = tftypes.BoolValue(kkp.BoolValueOrDefault(plan.WaitForReady, true))
+	state.ClusterIDs = tftypes.ListValueMust(tftypes.StringType, nil)
+
+	var clusterIDs []string
+	pollErr := kkp.PollWithTimeout(ctx, 5*time.Second, 10*time.Minute, func(pc context.Context) (bool, error) {
+		ids, lerr := r.instanceClusterIDs(pc, projectID, instance)
+		if lerr != nil {
+			// Keep polling through transient failures only; a wrong project or revoked
+			// token will not fix itself.
+			if kkp.IsTransient(lerr) {
+				return false, nil
+			}
+			return false, lerr
+		}
+		clusterIDs = ids
+		return int64(len(ids)) >= replicas, nil
+	})
+	resp.Diagnostics.Append(setClusterIDs(ctx, &state, clusterIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if pollErr != nil && !errors.Is(pollErr, context.DeadlineExceeded) && !errors.Is(pollErr, context.Canceled) {
+		kkp.AddAPIError(&resp.Diagnostics, "List template instance clusters failed", pollErr)
+		return
+	}
+	if pollErr != nil {
+		resp.Diagnostics.AddError(
+			"Created clusters not found",
+			fmt.Sprintf("Found %d of %d clusters labelled %s=%s: %s", len(clusterIDs), replicas, templateInstanceLabel, instance, pollErr.Error()),
+		)
+		return
+	}
+
+	if !state.WaitForReady.ValueBool() {
+		tflog.Info(ctx, "skipping cluster readiness check (wait_for_ready=false)", map[string]any{"instance": instance})
+		return
+	}
+	for _, id := range clusterIDs {
+		checker := &kkp.ClusterHealthChecker{
+			Client:    r.Client,
+			ProjectID: projectID,
+			ClusterID: id,
+		}
+		if err := checker.WaitForClusterReady(ctx); err != nil {
+			resp.Diagnostics.AddError("Cluster provisioning failed", fmt.Sprintf("cluster %s: %s", id, err.Error()))
+			return
+		}
+	}
+}
+
+func (r *resourceClusterTemplateInstance) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[templateInstanceState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	instance := kkp.TrimmedStringValue(state.ID)
+	if instance == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain template instance name.")
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	ids, err := r.instanceClusterIDs(ctx, projectID, instance)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Read clusters failed", err)
+		return
+	}
+	if len(ids) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.WaitForReady = tftypes.BoolValue(kkp.BoolValueOrDefault(state.WaitForReady, true))
+	if state.Replicas.IsNull() {
+		// Imported: the template reference is not recorded on the clusters.
+		state.Replicas = tftypes.Int64Value(int64(len(ids)))
+	}
+	resp.Diagnostics.Append(setClusterIDs(ctx, state, ids)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusterTemplateInstance) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[templateInstanceState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state templateInstanceState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Everything but wait_for_ready and a post-import template reference forces
+	// replacement, so only state needs updating.
+	final := *plan
+	final.ID = state.ID
+	final.ProjectID = tftypes.StringValue(r.ProjectID(plan.ProjectID))
+	final.WaitForReady = tftypes.BoolValue(kkp.BoolValueOrDefault(plan.WaitForReady, true))
+	final.ClusterIDs = state.ClusterIDs
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourceClusterTemplateInstance) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[templateInstanceState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	instance := kkp.TrimmedStringValue(state.ID)
+	if instance == "" {
+		return
+	}
+
+	// Look the clusters up again so replicas created after the last refresh are removed too.
+	projectID := r.ProjectID(state.ProjectID)
+	ids, err := r.instanceClusterIDs(ctx, projectID, instance)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "List clusters failed", err)
+		return
+	}
+
+	for _, id := range ids {
+		_, err := r.Client.Project.DeleteClusterV2(
+			kapi.NewDeleteClusterV2Params().WithContext(ctx).
+				WithProjectID(projectID).
+				WithClusterID(id),
+			nil,
+		)
+		if err != nil && !kkp.IsNotFound(err) {
+			// Deletion might still be progressing server-side; warn and continue to poll.
+			kkp.AddAPIWarning(&resp.Diagnostics, "Delete cluster warning", err)
+		}
+	}
+
+	for _, id := range ids {
+		checker := &kkp.ClusterHealthChecker{
+			Client:    r.Client,
+			ProjectID: projectID,
+			ClusterID: id,
+		}
+		if err := checker.WaitForClusterDeleted(ctx); err != nil {
+			resp.Diagnostics.AddError("Cluster delete polling failed", fmt.Sprintf("cluster %s: %s", id, err.Error()))
+			return
+		}
+	}
+}
+
+func (r *resourceClusterTemplateInstance) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<instance_name>' or '<project_id>:<instance_name>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// instanceClusterIDs returns the sorted IDs of the clusters labelled with the instance name.
+func (r *resourceClusterTemplateInstance) instanceClusterIDs(ctx context.Context, projectID, instance string) ([]string, error) {
+	clusters, err := r.Client.ListClusters(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0)
+	for _, c := range clusters {
+		if c != nil && c.Labels[templateInstanceLabel] == instance {
+			ids = append(ids, c.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// resolveTemplateID looks up a cluster template by its unique name.
+func (r *resourceClusterTemplateInstance) resolveTemplateID(ctx context.Context, projectID, name string) (string, error) {
+	out, err := r.Client.Project.ListClusterTemplates(
+		kapi.NewListClusterTemplatesParams().WithContext(ctx).WithProjectID(projectID),
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("list cluster templates: %s", kkp.APIErrorDetail(err))
+	}
+	matches := make([]string, 0)
+	for _, t := range out.Payload {
+		if t != nil && strings.TrimSpace(t.Name) == name {
+			matches = append(matches, t.ID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no cluster template named %q found in project %q", name, projectID)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("multiple cluster templates are named %q; use template_id instead", name)
+	}
+}
+
+func setClusterIDs(ctx context.Context, state *templateInstanceState, ids []string) diag.Diagnostics {
+	if ids == nil {
+		ids = []string{}
+	}
+	list, diags := tftypes.ListValueFrom(ctx, tftypes.StringType, ids)
+	state.ClusterIDs = list
+	return diags
+}
+
+// requiresReplaceUnlessImported forces replacement when the template reference changes,
+// except when it is set for the first time after an import.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing the template recreates all clusters.",
+		"Changing the template recreates all clusters.",
+	)
+}
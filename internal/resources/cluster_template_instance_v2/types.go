@@ -0,0 +1,25 @@
+package cluster_template_instance_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceClusterTemplateInstance struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type templateInstanceState struct {
+	ID           tftypes.String `tfsdk:"id"`            // computed: template instance name
+	ProjectID    tftypes.String `tfsdk:"project_id"`    // optional, defaults to the provider project
+	TemplateID   tftypes.String `tfsdk:"template_id"`   // one of template_id / template_name
+	TemplateName tftypes.String `tfsdk:"template_name"` // one of template_id / template_name
+	Replicas     tftypes.Int64  `tfsdk:"replicas"`      // required
+	WaitForReady tftypes.Bool   `tfsdk:"wait_for_ready"`
+	ClusterIDs   tftypes.List   `tfsdk:"cluster_ids"` // computed
+}
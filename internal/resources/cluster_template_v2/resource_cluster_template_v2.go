@@ -0,0 +1,750 @@
+// Package cluster_template_v2 implements the Terraform resource for KKP cluster templates.
+package cluster_template_v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/resources/cluster_v2"
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/resources/machine_deployment_v2"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+var (
+	_ resource.Resource                = &resourceClusterTemplate{}
+	_ resource.ResourceWithConfigure   = &resourceClusterTemplate{}
+	_ resource.ResourceWithImportState = &resourceClusterTemplate{}
+	_ resource.ResourceWithModifyPlan  = &resourceClusterTemplate{}
+)
+
+// Template scopes supported by KKP. Global templates require an admin token.
+var templateScopes = []string{"user", "project", "global"}
+
+// New creates a new cluster template v2 resource.
+func New() resource.Resource { return &resourceClusterTemplate{} }
+
+func (r *resourceClusterTemplate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_template_v2"
+}
+
+func (r *resourceClusterTemplate) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Cluster template in KKP, instantiated with template_id/template_name on kkp_cluster_v2. Uses the provider-level project_id unless project_id is set.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Cluster template ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"name": rschema.StringAttribute{
+				Required:    true,
+				Description: "Template name. Also used as the name of the cluster spec in the template.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scope": rschema.StringAttribute{
+				Required:    true,
+				Description: "Template visibility: user, project or global (global requires an admin token).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(templateScopes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"k8s_version": rschema.StringAttribute{
+				Required:    true,
+				Description: "Kubernetes version of clusters created from the template (e.g. 1.28.5).",
+			},
+			"datacenter": rschema.StringAttribute{
+				Required:    true,
+				Description: "KKP datacenter name (e.g. ewc-eumetsat).",
+			},
+			"preset": rschema.StringAttribute{
+				Optional:    true,
+				Description: "KKP preset/credential name. Leave empty when using OpenStack application credentials.",
+			},
+			"cloud": rschema.StringAttribute{
+				Required:    true,
+				Description: "Target cloud: openstack | aws | vsphere | azure.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(kkp.CloudOpenStack, kkp.CloudAWS, kkp.CloudVSphere, kkp.CloudAzure),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cni_type": rschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "CNI plugin type (default: cilium).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cni_version": rschema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "CNI plugin version (default: v1.14).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssh_key_ids": rschema.ListAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Existing SSH key IDs to assign to clusters created from the template.",
+			},
+			"creation_time": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Template creation timestamp (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]rschema.Block{
+			"openstack": rschema.SingleNestedBlock{
+				Description: "OpenStack cluster settings, as on kkp_cluster_v2.",
+				Attributes: map[string]rschema.Attribute{
+					"use_token": rschema.BoolAttribute{
+						Optional:    true,
+						Description: "Use token-based auth from preset (default: true when preset is set). Ignored in app-credential flow.",
+					},
+					"application_credential_id": rschema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "OpenStack application credential ID (required when no preset).",
+					},
+					"application_credential_secret": rschema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "OpenStack application credential secret (required when no preset).",
+					},
+					"domain": rschema.StringAttribute{
+						Optional:    true,
+						Description: "OpenStack domain name (e.g. 'default'). Usually provided by preset.",
+					},
+					"network": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Neutron network name or ID (required when no preset).",
+					},
+					"security_groups": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Security group name (required when no preset).",
+					},
+					"subnet_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "IPv4 subnet ID (required when no preset).",
+					},
+					"floating_ip_pool": rschema.StringAttribute{
+						Optional:    true,
+						Description: "External network / Floating IP pool (required when no preset).",
+					},
+				},
+			},
+			"machine_deployment": rschema.SingleNestedBlock{
+				Description: "Initial machine deployment of clusters created from the template, as on kkp_machine_deployment_v2.",
+				Attributes: map[string]rschema.Attribute{
+					"name": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Machine deployment name (required when the block is set).",
+					},
+					"replicas": rschema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Number of worker nodes (default 1).",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 1000),
+						},
+					},
+					"k8s_version": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Kubelet version (defaults to the cluster version).",
+					},
+				},
+				Blocks: map[string]rschema.Block{
+					"openstack": rschema.SingleNestedBlock{
+						Attributes: map[string]rschema.Attribute{
+							"flavor": rschema.StringAttribute{
+								Optional:    true,
+								Description: "OpenStack flavor (required for cloud=openstack).",
+							},
+							"image": rschema.StringAttribute{
+								Optional:    true,
+								Description: "Image name or UUID (required for cloud=openstack).",
+							},
+							"use_floating_ip": rschema.BoolAttribute{
+								Optional:    true,
+								Description: "Assign floating IPs to nodes.",
+							},
+							"disk_size": rschema.Int64Attribute{
+								Optional:    true,
+								Description: "Root disk size in GB.",
+							},
+							"availability_zone": rschema.StringAttribute{
+								Optional:    true,
+								Description: "Availability zone for the nodes.",
+							},
+						},
+					},
+				},
+			},
+			"application": rschema.ListNestedBlock{
+				Description: "Applications installed into clusters created from the template.",
+				NestedObject: rschema.NestedBlockObject{
+					Attributes: map[string]rschema.Attribute{
+						"name": rschema.StringAttribute{
+							Required:    true,
+							Description: "Name of the application (from ApplicationDefinitions).",
+						},
+						"version": rschema.StringAttribute{
+							Required:    true,
+							Description: "Version of the application.",
+						},
+						"namespace": rschema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Namespace to install the application into. Defaults to the application name.",
+						},
+						"values": rschema.StringAttribute{
+							Optional:    true,
+							Description: "Helm values as a YAML string.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceClusterTemplate) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceClusterTemplate) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
+func (r *resourceClusterTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[clusterTemplateState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	body, err := r.buildTemplateBody(ctx, plan, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid cluster template", err.Error())
+		return
+	}
+
+	out, err := r.Client.Project.CreateClusterTemplate(
+		kapi.NewCreateClusterTemplateParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithBody(body),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Create cluster template failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil || out.Payload.ID == "" {
+		resp.Diagnostics.AddError("Create cluster template failed", "KKP returned no cluster template ID.")
+		return
+	}
+
+	tflog.Info(ctx, "cluster template created", map[string]any{
+		"project_id":  projectID,
+		"template_id": out.Payload.ID,
+		"scope":       out.Payload.Scope,
+	})
+
+	state := *plan
+	applyTemplate(&state, out.Payload, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceClusterTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[clusterTemplateState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	templateID := kkp.TrimmedStringValue(state.ID)
+	if templateID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain cluster template id.")
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	out, err := r.Client.Project.GetClusterTemplate(
+		kapi.NewGetClusterTemplateParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterTemplateID(templateID),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Read cluster template failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	applyTemplate(state, out.Payload, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceClusterTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[clusterTemplateState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state clusterTemplateState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	templateID := kkp.TrimmedStringValue(state.ID)
+	if templateID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain cluster template id.")
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	body, err := r.buildTemplateBody(ctx, plan, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid cluster template", err.Error())
+		return
+	}
+
+	// KKP replaces the whole template; clusters created from it are not affected.
+	out, err := r.Client.Project.UpdateClusterTemplate(
+		kapi.NewUpdateClusterTemplateParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterTemplateID(templateID).
+			WithBody(kapi.UpdateClusterTemplateBody(body)),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Update cluster template failed", err)
+		return
+	}
+
+	final := *plan
+	final.ID = state.ID
+	final.CreationTime = state.CreationTime
+	if out != nil && out.Payload != nil {
+		applyTemplate(&final, out.Payload, projectID)
+	} else {
+		final.ProjectID = tftypes.StringValue(projectID)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourceClusterTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[clusterTemplateState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	templateID := kkp.TrimmedStringValue(state.ID)
+	if templateID == "" {
+		return
+	}
+
+	_, err := r.Client.Project.DeleteClusterTemplate(
+		kapi.NewDeleteClusterTemplateParams().WithContext(ctx).
+			WithProjectID(r.ProjectID(state.ProjectID)).
+			WithClusterTemplateID(templateID),
+		nil,
+	)
+	if err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIError(&resp.Diagnostics, "Delete cluster template failed", err)
+	}
+}
+
+func (r *resourceClusterTemplate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<template_id>' or '<project_id>:<template_id>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// buildTemplateBody builds the KKP template body from the plan, reusing the cluster and
+// machine deployment plan builders so templates follow the same defaults and validation.
+func (r *resourceClusterTemplate) buildTemplateBody(ctx context.Context, plan *clusterTemplateState, projectID string) (kapi.CreateClusterTemplateBody, error) {
+	body := kapi.CreateClusterTemplateBody{
+		Name:  strings.TrimSpace(plan.Name.ValueString()),
+		Scope: plan.Scope.ValueString(),
+	}
+
+	cp := clusterPlan(plan)
+	spec, err := cp.ToCreateSpec(ctx)
+	if err != nil {
+		return body, err
+	}
+	body.Cluster = spec.Cluster
+
+	if plan.MachineDeployment != nil {
+		mp, err := nodeDeploymentPlan(plan)
+		if err != nil {
+			return body, err
+		}
+		nd, err := mp.ToNodeDeploymentTemplate()
+		if err != nil {
+			return body, fmt.Errorf("machine_deployment: %w", err)
+		}
+		body.NodeDeployment = nd
+	}
+
+	keys, err := r.templateSSHKeys(ctx, plan, projectID)
+	if err != nil {
+		return body, err
+	}
+	body.UserSSHKeys = keys
+
+	body.Applications = make([]*models.Application, 0, len(plan.Applications))
+	for i, app := range plan.Applications {
+		plan.Applications[i].Namespace = tftypes.StringValue(applicationNamespace(app))
+		body.Applications = append(body.Applications, &models.Application{
+			Name: app.Name.ValueString(),
+			Spec: &models.ApplicationSpec{
+				ApplicationRef: &models.ApplicationRef{
+					Name:    app.Name.ValueString(),
+					Version: app.Version.ValueString(),
+				},
+				Namespace: &models.NamespaceSpec{
+					Name:   applicationNamespace(app),
+					Create: true,
+				},
+				ValuesBlock: app.Values.ValueString(),
+			},
+		})
+	}
+
+	return body, nil
+}
+
+// clusterPlan converts the template state into a cluster_v2 plan.
+func clusterPlan(plan *clusterTemplateState) *cluster_v2.Plan {
+	cp := &cluster_v2.Plan{
+		Name:       strings.TrimSpace(plan.Name.ValueString()),
+		K8sVersion: plan.K8sVersion.ValueString(),
+		Datacenter: plan.Datacenter.ValueString(),
+		Preset:     kkp.TrimmedStringValue(plan.Preset),
+		Cloud:      plan.Cloud.ValueString(),
+		CNI: cluster_v2.CNI{
+			Type:    kkp.TrimmedStringValue(plan.CNIType),
+			Version: kkp.TrimmedStringValue(plan.CNIVersion),
+		},
+	}
+
+	switch cp.Cloud {
+	case kkp.CloudOpenStack:
+		os := &cluster_v2.OpenStack{UseToken: cp.Preset != ""}
+		if plan.OpenStack != nil {
+			os.UseToken = kkp.BoolValueOrDefault(plan.OpenStack.UseToken, os.UseToken)
+			os.ApplicationCredentialID = kkp.TrimmedStringValue(plan.OpenStack.ApplicationCredentialID)
+			os.ApplicationCredentialSecret = kkp.TrimmedStringValue(plan.OpenStack.ApplicationCredentialSecret)
+			os.Domain = kkp.TrimmedStringValue(plan.OpenStack.Domain)
+			os.Network = kkp.TrimmedStringValue(plan.OpenStack.Network)
+			os.SubnetID = kkp.TrimmedStringValue(plan.OpenStack.SubnetID)
+			os.FloatingIPPool = kkp.TrimmedStringValue(plan.OpenStack.FloatingIPPool)
+			os.SecurityGroups = kkp.TrimmedStringValue(plan.OpenStack.SecurityGroups)
+		}
+		cp.OpenStack = os
+	case kkp.CloudAWS:
+		cp.AWS = &cluster_v2.AWS{}
+	case kkp.CloudVSphere:
+		cp.VSphere = &cluster_v2.VSphere{}
+	case kkp.CloudAzure:
+		cp.Azure = &cluster_v2.Azure{}
+	}
+	return cp
+}
+
+// nodeDeploymentPlan converts the machine_deployment block into a machine_deployment_v2 plan.
+func nodeDeploymentPlan(plan *clusterTemplateState) (*machine_deployment_v2.Plan, error) {
+	md := plan.MachineDeployment
+	replicas, err := kkp.SafeInt32(kkp.Int64ValueOrDefault(md.Replicas, int64(kkp.DefaultReplicas)))
+	if err != nil {
+		return nil, fmt.Errorf("machine_deployment.replicas: %w", err)
+	}
+
+	md.Replicas = tftypes.Int64Value(int64(replicas))
+
+	mp := &machine_deployment_v2.Plan{
+		Name:       kkp.TrimmedStringValue(md.Name),
+		Replicas:   replicas,
+		K8sVersion: kkp.TrimmedStringValue(md.K8sVersion),
+		Cloud:      plan.Cloud.ValueString(),
+	}
+
+	switch mp.Cloud {
+	case kkp.CloudOpenStack:
+		if md.OpenStack == nil {
+			break
+		}
+		diskSize, err := kkp.SafeInt32(kkp.Int64ValueOrDefault(md.OpenStack.DiskSize, 0))
+		if err != nil {
+			return nil, fmt.Errorf("machine_deployment.openstack.disk_size: %w", err)
+		}
+		mp.OpenStack = &machine_deployment_v2.OpenStack{
+			Flavor:           kkp.TrimmedStringValue(md.OpenStack.Flavor),
+			Image:            kkp.TrimmedStringValue(md.OpenStack.Image),
+			UseFloatingIP:    kkp.BoolValueOrDefault(md.OpenStack.UseFloatingIP, false),
+			DiskSize:         diskSize,
+			AvailabilityZone: kkp.TrimmedStringValue(md.OpenStack.AvailabilityZone),
+		}
+	case kkp.CloudAWS:
+		mp.AWS = &machine_deployment_v2.AWS{}
+	case kkp.CloudVSphere:
+		mp.VSphere = &machine_deployment_v2.VSphere{}
+	case kkp.CloudAzure:
+		mp.Azure = &machine_deployment_v2.Azure{}
+	}
+	return mp, nil
+}
+
+// templateSSHKeys resolves ssh_key_ids to the id/name pairs KKP stores on templates.
+func (r *resourceClusterTemplate) templateSSHKeys(ctx context.Context, plan *clusterTemplateState, projectID string) ([]*models.ClusterTemplateSSHKey, error) {
+	keys := make([]*models.ClusterTemplateSSHKey, 0)
+	if plan.SSHKeyIDs.IsNull() || plan.SSHKeyIDs.IsUnknown() {
+		return keys, nil
+	}
+	var ids []string
+	if diags := plan.SSHKeyIDs.ElementsAs(ctx, &ids, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid ssh_key_ids")
+	}
+	if len(ids) == 0 {
+		return keys, nil
+	}
+
+	out, err := r.Client.Project.ListSSHKeys(
+		kapi.NewListSSHKeysParams().WithContext(ctx).WithProjectID(projectID),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list SSH keys: %s", kkp.APIErrorDetail(err))
+	}
+	names := make(map[string]string, len(out.Payload))
+	for _, k := range out.Payload {
+		if k != nil {
+			names[k.ID] = k.Name
+		}
+	}
+
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		name, ok := names[id]
+		if !ok {
+			return nil, fmt.Errorf("SSH key %q not found in project %q", id, projectID)
+		}
+		keys = append(keys, &models.ClusterTemplateSSHKey{ID: id, Name: name})
+	}
+	return keys, nil
+}
+
+// applyTemplate copies the API view of a cluster template into state. Credentials and
+// application values are not returned in a comparable form and are kept from state.
+func applyTemplate(state *clusterTemplateState, t *models.ClusterTemplate, projectID string) {
+	state.ID = tftypes.StringValue(t.ID)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.Name = tftypes.StringValue(t.Name)
+	if t.Scope != "" {
+		state.Scope = tftypes.StringValue(t.Scope)
+	}
+	if !t.CreationTimestamp.IsZero() {
+		state.CreationTime = tftypes.StringValue(t.CreationTimestamp.String())
+	} else if state.CreationTime.IsUnknown() {
+		state.CreationTime = tftypes.StringNull()
+	}
+
+	if c := t.Cluster; c != nil {
+		if c.Credential != "" {
+			state.Preset = tftypes.StringValue(c.Credential)
+		} else if !state.Preset.IsNull() {
+			state.Preset = tftypes.StringNull()
+		}
+		if s := c.Spec; s != nil {
+			if string(s.Version) != "" {
+				state.K8sVersion = tftypes.StringValue(string(s.Version))
+			}
+			if s.Cloud != nil && s.Cloud.DatacenterName != "" {
+				state.Datacenter = tftypes.StringValue(s.Cloud.DatacenterName)
+			}
+			if cloud := templateCloud(s.Cloud); cloud != "" {
+				state.Cloud = tftypes.StringValue(cloud)
+			}
+			if s.CniPlugin != nil {
+				state.CNIType = tftypes.StringValue(string(s.CniPlugin.Type))
+				state.CNIVersion = tftypes.StringValue(s.CniPlugin.Version)
+			}
+		}
+	}
+	if state.CNIType.IsUnknown() {
+		state.CNIType = tftypes.StringNull()
+	}
+	if state.CNIVersion.IsUnknown() {
+		state.CNIVersion = tftypes.StringNull()
+	}
+
+	if md := state.MachineDeployment; md != nil {
+		if t.NodeDeployment != nil && t.NodeDeployment.Spec != nil && t.NodeDeployment.Spec.Replicas != nil {
+			md.Replicas = tftypes.Int64Value(int64(*t.NodeDeployment.Spec.Replicas))
+		}
+	}
+
+	ids := make([]string, 0, len(t.UserSSHKeys))
+	for _, k := range t.UserSSHKeys {
+		if k != nil && k.ID != "" {
+			ids = append(ids, k.ID)
+		}
+	}
+	// Keep an unset ssh_key_ids unset while the template has no keys; fill it in on import.
+	if !state.SSHKeyIDs.IsNull() || len(ids) > 0 {
+		state.SSHKeyIDs = sshKeyIDsValue(state.SSHKeyIDs, ids)
+	}
+
+	state.Applications = applyApplications(state.Applications, t.Applications)
+}
+
+// templateCloud returns the cloud name of a template's cluster spec, or "" if it is not one
+// of the supported clouds.
+func templateCloud(c *models.CloudSpec) string {
+	switch {
+	case c == nil:
+		return ""
+	case c.Openstack != nil:
+		return kkp.CloudOpenStack
+	case c.Aws != nil:
+		return kkp.CloudAWS
+	case c.Vsphere != nil:
+		return kkp.CloudVSphere
+	case c.Azure != nil:
+		return kkp.CloudAzure
+	}
+	return ""
+}
+
+// sshKeyIDsValue keeps the configured order when the API reports the same set of keys.
+func sshKeyIDsValue(current tftypes.List, ids []string) tftypes.List {
+	var known []string
+	for _, v := range current.Elements() {
+		if s, ok := v.(tftypes.String); ok {
+			known = append(known, s.ValueString())
+		}
+	}
+	if sameStringSet(known, ids) {
+		return current
+	}
+	sort.Strings(ids)
+	values := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, tftypes.StringValue(id))
+	}
+	list, _ := tftypes.ListValue(tftypes.StringType, values)
+	return list
+}
+
+// applyApplications refreshes the application blocks from the API, keeping values from
+// state for applications that are still present.
+func applyApplications(current []stateApplication, apps []*models.Application) []stateApplication {
+	values := make(map[string]tftypes.String, len(current))
+	for _, a := range current {
+		values[a.Name.ValueString()] = a.Values
+	}
+
+	var result []stateApplication
+	for _, app := range apps {
+		if app == nil || app.Spec == nil || app.Spec.ApplicationRef == nil {
+			continue
+		}
+		el := stateApplication{
+			Name:      tftypes.StringValue(app.Spec.ApplicationRef.Name),
+			Version:   tftypes.StringValue(app.Spec.ApplicationRef.Version),
+			Namespace: tftypes.StringValue(app.Spec.ApplicationRef.Name),
+			Values:    tftypes.StringNull(),
+		}
+		if app.Spec.Namespace != nil && app.Spec.Namespace.Name != "" {
+			el.Namespace = tftypes.StringValue(app.Spec.Namespace.Name)
+		}
+		if v, ok := values[app.Spec.ApplicationRef.Name]; ok {
+			el.Values = v
+		}
+		result = append(result, el)
+	}
+	return result
+}
+
+func applicationNamespace(app stateApplication) string {
+	if ns := kkp.TrimmedStringValue(app.Namespace); ns != "" {
+		return ns
+	}
+	return app.Name.ValueString()
+}
+
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]int, len(a))
+	for _, s := range a {
+		seen[s]++
+	}
+	for _, s := range b {
+		if seen[s] == 0 {
+			return false
+		}
+		seen[s]--
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package cluster_template_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceClusterTemplate struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type stateOpenStack struct {
+	UseToken                    tftypes.Bool   `tfsdk:"use_token"`
+	ApplicationCredentialID     tftypes.String `tfsdk:"application_credential_id"`
+	ApplicationCredentialSecret tftypes.String `tfsdk:"application_credential_secret"`
+	Domain                      tftypes.String `tfsdk:"domain"`
+	Network                     tftypes.String `tfsdk:"network"`
+	SecurityGroups              tftypes.String `tfsdk:"security_groups"`
+	SubnetID                    tftypes.String `tfsdk:"subnet_id"`
+	FloatingIPPool              tftypes.String `tfsdk:"floating_ip_pool"`
+}
+
+type stateNodeOpenStack struct {
+	Flavor           tftypes.String `tfsdk:"flavor"`
+	Image            tftypes.String `tfsdk:"image"`
+	UseFloatingIP    tftypes.Bool   `tfsdk:"use_floating_ip"`
+	DiskSize         tftypes.Int64  `tfsdk:"disk_size"`
+	AvailabilityZone tftypes.String `tfsdk:"availability_zone"`
+}
+
+type stateMachineDeployment struct {
+	Name       tftypes.String      `tfsdk:"name"`
+	Replicas   tftypes.Int64       `tfsdk:"replicas"`
+	K8sVersion tftypes.String      `tfsdk:"k8s_version"`
+	OpenStack  *stateNodeOpenStack `tfsdk:"openstack"`
+}
+
+type stateApplication struct {
+	Name      tftypes.String `tfsdk:"name"`
+	Version   tftypes.String `tfsdk:"version"`
+	Namespace tftypes.String `tfsdk:"namespace"` // optional, defaults to the application name
+	Values    tftypes.String `tfsdk:"values"`    // optional: Helm values as YAML
+}
+
+type clusterTemplateState struct {
+	ID           tftypes.String `tfsdk:"id"`         // computed
+	ProjectID    tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
+	Name         tftypes.String `tfsdk:"name"`
+	Scope        tftypes.String `tfsdk:"scope"` // user | project | global
+	K8sVersion   tftypes.String `tfsdk:"k8s_version"`
+	Datacenter   tftypes.String `tfsdk:"datacenter"`
+	Preset       tftypes.String `tfsdk:"preset"`
+	Cloud        tftypes.String `tfsdk:"cloud"`
+	CNIType      tftypes.String `tfsdk:"cni_type"`
+	CNIVersion   tftypes.String `tfsdk:"cni_version"`
+	SSHKeyIDs    tftypes.List   `tfsdk:"ssh_key_ids"`
+	CreationTime tftypes.String `tfsdk:"creation_time"` // computed
+
+	OpenStack         *stateOpenStack         `tfsdk:"openstack"`
+	MachineDeployment *stateMachineDeployment `tfsdk:"machine_deployment"`
+	Applications      []stateApplication      `tfsdk:"application"`
+}
@@ -2,13 +2,14 @@ package cluster_v2
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"sort"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure        = &resourceCluster{}
 	_ resource.ResourceWithImportState      = &resourceCluster{}
 	_ resource.ResourceWithConfigValidators = &resourceCluster{}
+	_ resource.ResourceWithModifyPlan       = &resourceCluster{}
 )
 
 // New creates a new cluster v2 resource.
@@ -40,12 +42,13 @@ func (r *resourceCluster) Metadata(_ context.Context, req resource.MetadataReque
 
 func (r *resourceCluster) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
-		Description: "Create a KKP cluster in the provider-level project (modular cloud support).",
+		Description: "Create a KKP cluster in the provider-level project, or in project_id when set (modular cloud support).",
 		Attributes: map[string]rschema.Attribute{
 			"id": rschema.StringAttribute{
 				Computed:    true,
 				Description: "Cluster ID.",
 			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
 			"use_template": rschema.BoolAttribute{
 				Optional:    true,
 				Description: "When true with template_id set, create the cluster by instantiating a Cluster Template (V2).",
@@ -66,7 +69,7 @@ func (r *resourceCluster) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"template_replicas": rschema.Int64Attribute{
 				Optional:    true,
-				Description: "Number of template instances to create (default 1).",
+				Description: "Number of template instances to create (default 1). Only the cluster matching name is managed; use kkp_cluster_template_instance_v2 to manage every replica.",
 			},
 			"name": rschema.StringAttribute{
 				Required:    true,
@@ -104,7 +107,7 @@ func (r *resourceCluster) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 				ElementType: tftypes.StringType,
-				Description: "Existing SSH key IDs to assign to the cluster.",
+				Description: "Existing SSH key IDs to assign to the cluster. Removing an ID from the list detaches that key; keys assigned by other means, e.g. kkp_cluster_ssh_key_attachment_v2, are left alone. Unsetting the list stops managing the keys without detaching them.",
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -121,8 +124,42 @@ func (r *resourceCluster) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Description: "CNI plugin version (default: v1.14).",
 			},
+			"wait_for_ready": rschema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Wait for the cluster to become healthy during creation. Defaults to true. Set to false to return as soon as KKP accepts the cluster and gate dependents with kkp_cluster_readiness_v2.",
+			},
+			"health": rschema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Per-component health reported by KKP, refreshed on every read.",
+				Attributes:  healthSchemaAttributes(),
+			},
 		},
 		Blocks: map[string]rschema.Block{
+			"readiness": rschema.SingleNestedBlock{
+				Description: "Readiness policy applied when waiting for the cluster to become healthy after create and update.",
+				Attributes: map[string]rschema.Attribute{
+					"required_components": rschema.ListAttribute{
+						Optional:    true,
+						ElementType: tftypes.StringType,
+						Description: "Components that must be up (names as in the health attribute, e.g. gatekeeper_controller, mla_gateway). Defaults to apiserver, controller, scheduler, etcd, machine_controller and operating_system_manager.",
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(kkp.HealthComponentNames()...)),
+						},
+					},
+					"consecutive_healthy_polls": rschema.Int64Attribute{
+						Optional:    true,
+						Description: "Number of consecutive healthy polls required before the cluster counts as ready (default 1).",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"allow_degraded": rschema.BoolAttribute{
+						Optional:    true,
+						Description: "Accept a cluster that is serving (API server and etcd up) while other required components are not up yet (default false).",
+					},
+				},
+			},
 			"openstack": rschema.SingleNestedBlock{
 				Attributes: map[string]rschema.Attribute{
 					"use_token": rschema.BoolAttribute{
@@ -176,6 +213,10 @@ func (r *resourceCluster) Configure(_ context.Context, req resource.ConfigureReq
 	r.ConfigureResource(req, resp)
 }
 
+func (r *resourceCluster) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
 // nolint:gocyclo // Create handles multiple creation paths (direct and template-based) for clarity.
 func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.ValidateResourceBase(resp) {
@@ -186,6 +227,8 @@ func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest
 	if !ok {
 		return
 	}
+	projectID := r.ProjectID(plan.ProjectID)
+	plan.ProjectID = tftypes.StringValue(projectID)
 
 	manageSSHKeys := false
 	desiredSSHKeyIDs := make([]string, 0)
@@ -199,7 +242,14 @@ func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 		manageSSHKeys = true
-		desiredSSHKeyIDs = normalizeStringIDs(rawIDs)
+		desiredSSHKeyIDs = kkp.NormalizeIDs(rawIDs)
+	}
+
+	waitForReady := kkp.BoolValueOrDefault(plan.WaitForReady, true)
+	readiness, diags := readinessPolicy(ctx, plan.Readiness)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// --- Optional: Template-based creation path ---
@@ -221,14 +271,14 @@ func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
-		pcli := kapi.New(r.Client.Transport, nil)
+		pcli := r.Client.Project
 
 		// If only template_name provided, resolve it to an ID via ListClusterTemplates
 		if templateID == "" && templateName != "" {
-			lres, lerr := pcli.ListClusterTemplates(kapi.NewListClusterTemplatesParams().WithProjectID(r.DefaultProjectID), nil)
+			lres, lerr := pcli.ListClusterTemplates(kapi.NewListClusterTemplatesParams().WithContext(ctx).WithProjectID(projectID), nil)
 			if lerr != nil || lres == nil {
 				if lerr != nil {
-					resp.Diagnostics.AddError("Failed to resolve template by name", lerr.Error())
+					kkp.AddAPIError(&resp.Diagnostics, "Failed to resolve template by name", lerr)
 				} else {
 					resp.Diagnostics.AddError("Failed to resolve template by name", "empty response")
 				}
@@ -259,36 +309,31 @@ func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 		_, err := pcli.CreateClusterTemplateInstance(
-			kapi.NewCreateClusterTemplateInstanceParams().
-				WithProjectID(r.DefaultProjectID).
+			kapi.NewCreateClusterTemplateInstanceParams().WithContext(ctx).
+				WithProjectID(projectID).
 				WithClusterTemplateID(templateID).
 				WithBody(kapi.CreateClusterTemplateInstanceBody{Replicas: replicas}),
 			nil,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError("Create cluster from template failed", err.Error())
+			kkp.AddAPIError(&resp.Diagnostics, "Create cluster from template failed", err)
 			return
 		}
 
 		// Resolve cluster ID by polling ListClustersV2 for appearance of clusterName
 		var clusterID string
-		findErr := kkp.PollWithTimeout(ctx, 5*time.Second, 10*time.Minute, func(_ context.Context) (bool, error) {
-			list, lerr := pcli.ListClustersV2(
-				kapi.NewListClustersV2Params().WithProjectID(r.DefaultProjectID),
-				nil,
-			)
-			if lerr != nil || list == nil || list.Payload == nil {
+		findErr := kkp.PollWithTimeout(ctx, 5*time.Second, 10*time.Minute, func(pc context.Context) (bool, error) {
+			clusters, lerr := r.Client.ListClusters(pc, projectID)
+			if lerr != nil {
 				return false, nil
 			}
-			if list.Payload != nil && list.Payload.Clusters != nil {
-				for _, c := range list.Payload.Clusters {
-					if c == nil {
-						continue
-					}
-					if strings.TrimSpace(c.Name) == clusterName {
-						clusterID = c.ID
-						return true, nil
-					}
+			for _, c := range clusters {
+				if c == nil {
+					continue
+				}
+				if strings.TrimSpace(c.Name) == clusterName {
+					clusterID = c.ID
+					return true, nil
 				}
 			}
 			return false, nil
@@ -302,22 +347,28 @@ func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
-		// Wait for ready using the existing checker
-		checker := &kkp.ClusterHealthChecker{
-			Client:    r.Client,
-			ProjectID: r.DefaultProjectID,
-			ClusterID: clusterID,
-		}
-		if err := checker.WaitForClusterReady(ctx); err != nil {
-			resp.Diagnostics.AddError("Cluster provisioning timed out", err.Error())
-			return
+		if waitForReady {
+			checker := &kkp.ClusterHealthChecker{
+				Client:    r.Client,
+				ProjectID: projectID,
+				ClusterID: clusterID,
+				Readiness: readiness,
+			}
+			if err := checker.WaitForClusterReady(ctx); err != nil {
+				resp.Diagnostics.AddError(waitErrorSummary(err, "Cluster provisioning"), err.Error())
+				return
+			}
+		} else {
+			tflog.Info(ctx, "skipping cluster readiness check (wait_for_ready=false)", map[string]any{"cluster_id": clusterID})
 		}
 
 		// Persist minimal state; keep planned fields untouched except ID and Name
 		state := plan
 		state.ID = tftypes.StringValue(clusterID)
+		state.WaitForReady = tftypes.BoolValue(waitForReady)
+		state.Health = r.fetchHealth(ctx, projectID, clusterID)
 		// Refresh name from API for accuracy
-		if got, gerr := pcli.GetClusterV2(kapi.NewGetClusterV2Params().WithProjectID(r.DefaultProjectID).WithClusterID(clusterID), nil); gerr == nil && got != nil && got.Payload != nil {
+		if got, gerr := pcli.GetClusterV2(kapi.NewGetClusterV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID), nil); gerr == nil && got != nil && got.Payload != nil {
 			state.Name = tftypes.StringValue(got.Payload.Name)
 		}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -370,42 +421,42 @@ func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	params := kapi.NewCreateClusterV2Params().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	params := kapi.NewCreateClusterV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithBody(spec)
 
 	out, err := pcli.CreateClusterV2(params, nil)
 	if err != nil {
-		if e, ok := err.(*kapi.CreateClusterV2Default); ok && e.Payload != nil {
-			b, _ := json.MarshalIndent(e.Payload, "", "  ")
-			resp.Diagnostics.AddError("Create cluster failed", string(b))
-			return
-		}
-		resp.Diagnostics.AddError("Create cluster failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Create cluster failed", err)
 		return
 	}
 
 	clusterID := out.Payload.ID
 
 	// --------- Wait for cluster to become ready ----------
-	checker := &kkp.ClusterHealthChecker{
-		Client:    r.Client,
-		ProjectID: r.DefaultProjectID,
-		ClusterID: clusterID,
-	}
+	if waitForReady {
+		checker := &kkp.ClusterHealthChecker{
+			Client:    r.Client,
+			ProjectID: projectID,
+			ClusterID: clusterID,
+			Readiness: readiness,
+		}
 
-	if err := checker.WaitForClusterReady(ctx); err != nil {
-		resp.Diagnostics.AddError("Cluster provisioning timed out", err.Error())
-		return
+		if err := checker.WaitForClusterReady(ctx); err != nil {
+			resp.Diagnostics.AddError(waitErrorSummary(err, "Cluster provisioning"), err.Error())
+			return
+		}
+	} else {
+		tflog.Info(ctx, "skipping cluster readiness check (wait_for_ready=false)", map[string]any{"cluster_id": clusterID})
 	}
 
 	var finalSSHKeyIDs []string
 	if manageSSHKeys {
 		var err error
-		finalSSHKeyIDs, err = r.syncClusterSSHKeys(ctx, pcli, clusterID, desiredSSHKeyIDs)
+		finalSSHKeyIDs, err = kkp.SyncClusterSSHKeys(ctx, pcli, projectID, clusterID, desiredSSHKeyIDs, nil)
 		if err != nil {
-			resp.Diagnostics.AddError("Assign SSH keys to cluster failed", err.Error())
+			kkp.AddAPIError(&resp.Diagnostics, "Assign SSH keys to cluster failed", err)
 			return
 		}
 	}
@@ -414,6 +465,8 @@ func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest
 	state := plan
 	state.ID = tftypes.StringValue(clusterID)
 	state.Name = tftypes.StringValue(out.Payload.Name)
+	state.WaitForReady = tftypes.BoolValue(waitForReady)
+	state.Health = r.fetchHealth(ctx, projectID, clusterID)
 	if manageSSHKeys {
 		listValue, diags := tftypes.ListValueFrom(ctx, tftypes.StringType, finalSSHKeyIDs)
 		resp.Diagnostics.Append(diags...)
@@ -441,29 +494,33 @@ func (r *resourceCluster) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.AddError("Missing id", "State did not contain cluster id.")
 		return
 	}
+	projectID := r.ProjectID(state.ProjectID)
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	get := kapi.NewGetClusterV2Params().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	get := kapi.NewGetClusterV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(id)
 	got, err := pcli.GetClusterV2(get, nil)
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "not found") || strings.Contains(err.Error(), "404") {
+		if kkp.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Read cluster failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Read cluster failed", err)
 		return
 	}
 
 	state.ID = tftypes.StringValue(got.Payload.ID)
+	state.ProjectID = tftypes.StringValue(projectID)
 	state.Name = tftypes.StringValue(got.Payload.Name)
+	state.WaitForReady = tftypes.BoolValue(kkp.BoolValueOrDefault(state.WaitForReady, true))
+	state.Health = r.fetchHealth(ctx, projectID, id)
 	if state.SSHKeyIDs.IsNull() || state.SSHKeyIDs.IsUnknown() {
 		state.SSHKeyIDs = tftypes.ListNull(tftypes.StringType)
 	} else {
-		sshKeys, err := r.fetchClusterSSHKeys(ctx, pcli, id)
+		sshKeys, err := kkp.ClusterSSHKeyIDs(ctx, pcli, projectID, id)
 		if err != nil {
-			resp.Diagnostics.AddError("List cluster SSH keys failed", err.Error())
+			kkp.AddAPIError(&resp.Diagnostics, "List cluster SSH keys failed", err)
 			return
 		}
 		var currentStateSSHKeys []string
@@ -472,7 +529,8 @@ func (r *resourceCluster) Read(ctx context.Context, req resource.ReadRequest, re
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		sshKeys = orderStringIDsByPreference(sshKeys, currentStateSSHKeys)
+		// Keys assigned outside ssh_key_ids are not drift.
+		sshKeys = kkp.ManagedSSHKeyIDs(sshKeys, currentStateSSHKeys)
 		listValue, diags := tftypes.ListValueFrom(ctx, tftypes.StringType, sshKeys)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -505,6 +563,17 @@ func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest
 		resp.Diagnostics.AddError("Missing id", "State did not contain cluster id.")
 		return
 	}
+	projectID := r.ProjectID(state.ProjectID)
+	plan.ProjectID = tftypes.StringValue(projectID)
+
+	// wait_for_ready only affects creation; carry the configured value (or the previous one) forward.
+	plan.WaitForReady = tftypes.BoolValue(kkp.BoolValueOrDefault(plan.WaitForReady, kkp.BoolValueOrDefault(state.WaitForReady, true)))
+
+	readiness, diags := readinessPolicy(ctx, plan.Readiness)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	manageSSHKeys := false
 	desiredSSHKeyIDs := make([]string, 0)
@@ -516,7 +585,15 @@ func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest
 			return
 		}
 		manageSSHKeys = true
-		desiredSSHKeyIDs = normalizeStringIDs(rawIDs)
+		desiredSSHKeyIDs = kkp.NormalizeIDs(rawIDs)
+	}
+	// Only keys this resource assigned before may be detached.
+	var previousSSHKeyIDs []string
+	if !state.SSHKeyIDs.IsNull() && !state.SSHKeyIDs.IsUnknown() {
+		resp.Diagnostics.Append(state.SSHKeyIDs.ElementsAs(ctx, &previousSSHKeyIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Decide what changed
@@ -536,12 +613,13 @@ func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Nothing to change -> just keep state
 	if !needVersion && !needCNI && !needPreset && !manageSSHKeys {
+		plan.Health = r.fetchHealth(ctx, projectID, id)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
 
 	// ---- build minimal patch (matches KKP spec shape) ----
-	pcli := kapi.New(r.Client.Transport, nil)
+	pcli := r.Client.Project
 
 	if needVersion || needCNI || needPreset {
 		patchBody := map[string]any{}
@@ -564,14 +642,14 @@ func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest
 		}
 
 		_, err := pcli.PatchClusterV2(
-			kapi.NewPatchClusterV2Params().
-				WithProjectID(r.DefaultProjectID).
+			kapi.NewPatchClusterV2Params().WithContext(ctx).
+				WithProjectID(projectID).
 				WithClusterID(id).
 				WithPatch(patchBody),
 			nil,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError("Patch cluster failed", err.Error())
+			kkp.AddAPIError(&resp.Diagnostics, "Patch cluster failed", err)
 			return
 		}
 		tflog.Info(ctx, "patch sent", map[string]any{
@@ -585,8 +663,9 @@ func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest
 		// ---- wait for update to complete ----
 		checker := &kkp.ClusterHealthChecker{
 			Client:    r.Client,
-			ProjectID: r.DefaultProjectID,
+			ProjectID: projectID,
 			ClusterID: id,
+			Readiness: readiness,
 		}
 
 		if needVersion || needCNI {
@@ -597,21 +676,21 @@ func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest
 			}
 
 			if err := checker.WaitForClusterUpdated(ctx, expectedSpec); err != nil {
-				resp.Diagnostics.AddError("Cluster update timed out", err.Error())
+				resp.Diagnostics.AddError(waitErrorSummary(err, "Cluster update"), err.Error())
 				return
 			}
 		} else if needPreset {
 			if err := checker.WaitForClusterReady(ctx); err != nil {
-				resp.Diagnostics.AddError("Cluster preset update timed out", err.Error())
+				resp.Diagnostics.AddError(waitErrorSummary(err, "Cluster preset update"), err.Error())
 				return
 			}
 		}
 	}
 
 	if manageSSHKeys {
-		finalSSH, err := r.syncClusterSSHKeys(ctx, pcli, id, desiredSSHKeyIDs)
+		finalSSH, err := kkp.SyncClusterSSHKeys(ctx, pcli, projectID, id, desiredSSHKeyIDs, previousSSHKeyIDs)
 		if err != nil {
-			resp.Diagnostics.AddError("Sync cluster SSH keys failed", err.Error())
+			kkp.AddAPIError(&resp.Diagnostics, "Sync cluster SSH keys failed", err)
 			return
 		}
 		listValue, diags := tftypes.ListValueFrom(ctx, tftypes.StringType, finalSSH)
@@ -626,6 +705,7 @@ func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Success: write new state (preserve id)
 	plan.ID = state.ID
+	plan.Health = r.fetchHealth(ctx, projectID, id)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -642,21 +722,22 @@ func (r *resourceCluster) Delete(ctx context.Context, req resource.DeleteRequest
 	if id == "" {
 		return
 	}
+	projectID := r.ProjectID(state.ProjectID)
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	del := kapi.NewDeleteClusterV2Params().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	del := kapi.NewDeleteClusterV2Params().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(id)
 
-	if _, err := pcli.DeleteClusterV2(del, nil); err != nil {
+	if _, err := pcli.DeleteClusterV2(del, nil); err != nil && !kkp.IsNotFound(err) {
 		// Deletion might still be progressing server-side; warn and continue to poll.
-		resp.Diagnostics.AddWarning("Delete cluster warning", err.Error())
+		kkp.AddAPIWarning(&resp.Diagnostics, "Delete cluster warning", err)
 	}
 
 	// --- wait for cluster deletion to complete ---
 	checker := &kkp.ClusterHealthChecker{
 		Client:    r.Client,
-		ProjectID: r.DefaultProjectID,
+		ProjectID: projectID,
 		ClusterID: id,
 	}
 
@@ -673,152 +754,88 @@ func (r *resourceCluster) Delete(ctx context.Context, req resource.DeleteRequest
 	resp.State.RemoveResource(ctx)
 }
 
-func (r *resourceCluster) fetchClusterSSHKeys(ctx context.Context, pcli kapi.ClientService, clusterID string) ([]string, error) {
-	list, err := pcli.ListSSHKeysAssignedToClusterV2(
-		kapi.NewListSSHKeysAssignedToClusterV2Params().
-			WithProjectID(r.DefaultProjectID).
-			WithClusterID(clusterID),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	ids := make([]string, 0)
-	if list != nil && list.Payload != nil {
-		for _, key := range list.Payload {
-			if key == nil {
-				continue
-			}
-			ids = append(ids, key.ID)
-		}
+// fetchHealth returns the per-component health of the cluster, or a null object when
+// the health endpoint is not reachable yet (e.g. while the control plane is provisioning).
+func (r *resourceCluster) fetchHealth(ctx context.Context, projectID, clusterID string) tftypes.Object {
+	checker := &kkp.ClusterHealthChecker{
+		Client:    r.Client,
+		ProjectID: projectID,
+		ClusterID: clusterID,
 	}
-
-	return normalizeStringIDs(ids), nil
-}
-
-func (r *resourceCluster) syncClusterSSHKeys(ctx context.Context, pcli kapi.ClientService, clusterID string, desired []string) ([]string, error) {
-	desiredIDs := normalizeStringIDs(desired)
-	currentIDs, err := r.fetchClusterSSHKeys(ctx, pcli, clusterID)
+	h, err := checker.FetchHealth(ctx)
 	if err != nil {
-		return nil, err
-	}
-
-	desiredSet := make(map[string]struct{}, len(desiredIDs))
-	for _, id := range desiredIDs {
-		desiredSet[id] = struct{}{}
-	}
-	currentSet := make(map[string]struct{}, len(currentIDs))
-	for _, id := range currentIDs {
-		currentSet[id] = struct{}{}
-	}
-
-	assign := make([]string, 0)
-	for _, id := range desiredIDs {
-		if _, ok := currentSet[id]; !ok {
-			assign = append(assign, id)
-		}
-	}
-
-	detach := make([]string, 0)
-	for _, id := range currentIDs {
-		if _, ok := desiredSet[id]; !ok {
-			detach = append(detach, id)
-		}
-	}
-
-	for _, id := range assign {
-		params := kapi.NewAssignSSHKeyToClusterV2Params().
-			WithProjectID(r.DefaultProjectID).
-			WithClusterID(clusterID).
-			WithKeyID(id)
-		if _, err := pcli.AssignSSHKeyToClusterV2(params, nil); err != nil {
-			return nil, err
-		}
-		tflog.Info(ctx, "assigned SSH key to cluster", map[string]any{
+		tflog.Debug(ctx, "cluster health not available", map[string]any{
 			"cluster_id": clusterID,
-			"ssh_key_id": id,
+			"error":      err.Error(),
 		})
+		h = nil
 	}
-
-	for _, id := range detach {
-		params := kapi.NewDetachSSHKeyFromClusterV2Params().
-			WithProjectID(r.DefaultProjectID).
-			WithClusterID(clusterID).
-			WithKeyID(id)
-		if _, err := pcli.DetachSSHKeyFromClusterV2(params, nil); err != nil {
-			return nil, err
-		}
-		tflog.Info(ctx, "detached SSH key from cluster", map[string]any{
-			"cluster_id": clusterID,
-			"ssh_key_id": id,
-		})
-	}
-
-	if len(assign) == 0 && len(detach) == 0 {
-		return desiredIDs, nil
+	value, diags := kkp.HealthObjectValue(h)
+	if diags.HasError() {
+		return tftypes.ObjectNull(kkp.HealthAttributeTypes())
 	}
-
-	return desiredIDs, nil
+	return value
 }
 
-func normalizeStringIDs(ids []string) []string {
-	seen := make(map[string]struct{}, len(ids))
-	result := make([]string, 0, len(ids))
-	for _, id := range ids {
-		trimmed := strings.TrimSpace(id)
-		if trimmed == "" {
-			continue
-		}
-		if _, ok := seen[trimmed]; ok {
-			continue
-		}
-		seen[trimmed] = struct{}{}
-		result = append(result, trimmed)
-	}
-	return result
+// waitErrorSummary picks the diagnostic summary for a failed wait: waits that ran out of
+// time are timeouts, interrupted waits are cancellations, terminal cluster and API errors
+// are failures.
+func waitErrorSummary(err error, operation string) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return operation + " timed out"
+	case errors.Is(err, context.Canceled):
+		return operation + " cancelled"
+	}
+	return operation + " failed"
 }
 
-func orderStringIDsByPreference(ids, preference []string) []string {
-	normalizedIDs := normalizeStringIDs(ids)
-	normalizedPreference := normalizeStringIDs(preference)
-
-	idSet := make(map[string]struct{}, len(normalizedIDs))
-	for _, id := range normalizedIDs {
-		idSet[id] = struct{}{}
+// readinessPolicy converts the readiness block into a kkp.ReadinessPolicy. A missing
+// block yields the default policy.
+func readinessPolicy(ctx context.Context, block *stateReadiness) (kkp.ReadinessPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := kkp.ReadinessPolicy{}
+	if block == nil {
+		return policy, diags
 	}
 
-	result := make([]string, 0, len(normalizedIDs))
-	used := make(map[string]struct{}, len(normalizedIDs))
-
-	for _, id := range normalizedPreference {
-		if _, ok := idSet[id]; !ok {
-			continue
-		}
-		if _, ok := used[id]; ok {
-			continue
+	if !block.RequiredComponents.IsNull() && !block.RequiredComponents.IsUnknown() {
+		var components []string
+		diags.Append(block.RequiredComponents.ElementsAs(ctx, &components, false)...)
+		if diags.HasError() {
+			return policy, diags
 		}
-		used[id] = struct{}{}
-		result = append(result, id)
+		policy.RequiredComponents = components
 	}
+	policy.ConsecutiveSuccesses = int(kkp.Int64ValueOrDefault(block.ConsecutiveHealthyPolls, 1))
+	policy.AllowDegraded = kkp.BoolValueOrDefault(block.AllowDegraded, false)
 
-	additionalIDs := make([]string, 0, len(normalizedIDs))
-	for _, id := range normalizedIDs {
-		if _, ok := used[id]; ok {
-			continue
-		}
-		additionalIDs = append(additionalIDs, id)
+	if err := policy.Validate(); err != nil {
+		diags.AddAttributeError(path.Root("readiness").AtName("required_components"), "Invalid readiness policy", err.Error())
 	}
-	sort.Strings(additionalIDs)
-	result = append(result, additionalIDs...)
+	return policy, diags
+}
 
-	return result
+// healthSchemaAttributes builds the nested attributes of the health attribute.
+func healthSchemaAttributes() map[string]rschema.Attribute {
+	attrs := map[string]rschema.Attribute{}
+	for name, description := range kkp.HealthAttributeDescriptions() {
+		attrs[name] = rschema.StringAttribute{
+			Computed:    true,
+			Description: description,
+		}
+	}
+	return attrs
 }
 
 func (r *resourceCluster) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := strings.TrimSpace(req.ID)
+	// Import format: [project_id:]cluster_id
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if id == "" {
-		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<cluster_id>'")
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<cluster_id>' or '<project_id>:<cluster_id>'")
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
@@ -82,12 +82,19 @@ type stateOpenStack struct {
 	FloatingIPPool              tftypes.String `tfsdk:"floating_ip_pool"`
 }
 
+type stateReadiness struct {
+	RequiredComponents      tftypes.List  `tfsdk:"required_components"`
+	ConsecutiveHealthyPolls tftypes.Int64 `tfsdk:"consecutive_healthy_polls"`
+	AllowDegraded           tftypes.Bool  `tfsdk:"allow_degraded"`
+}
+
 type stateAWS struct{}
 type stateVSphere struct{}
 type stateAzure struct{}
 
 type clusterState struct {
 	ID         tftypes.String `tfsdk:"id"`
+	ProjectID  tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
 	Name       tftypes.String `tfsdk:"name"`
 	K8sVersion tftypes.String `tfsdk:"k8s_version"`
 	Datacenter tftypes.String `tfsdk:"datacenter"`
@@ -97,6 +104,13 @@ type clusterState struct {
 	CNIVersion tftypes.String `tfsdk:"cni_version"`
 	SSHKeyIDs  tftypes.List   `tfsdk:"ssh_key_ids"`
 
+	// Readiness control
+	WaitForReady tftypes.Bool    `tfsdk:"wait_for_ready"`
+	Readiness    *stateReadiness `tfsdk:"readiness"`
+
+	// Observed health (computed)
+	Health tftypes.Object `tfsdk:"health"`
+
 	// Template-based creation (optional)
 	UseTemplate      tftypes.Bool   `tfsdk:"use_template"`
 	TemplateID       tftypes.String `tfsdk:"template_id"`
@@ -35,6 +35,12 @@ func (p *Plan) Validate() error {
 	if err := kkp.ValidateRequiredString(p.ClusterID, "cluster_id"); err != nil {
 		return err
 	}
+	return p.validateNodeSpec()
+}
+
+// validateNodeSpec validates everything but the target cluster, so the same
+// rules apply to node deployments embedded in cluster templates.
+func (p *Plan) validateNodeSpec() error {
 	if err := kkp.ValidateCloudProvider(p.Cloud); err != nil {
 		return err
 	}
@@ -105,6 +111,25 @@ func (p *Plan) ToMachineDeploymentSpec() (*models.NodeDeployment, error) {
 	return kkp.ExecuteToModel(p, p.buildMachineDeploymentSpec)
 }
 
+// ToNodeDeploymentTemplate converts the plan to a node deployment that is not bound
+// to a cluster yet, as embedded in cluster templates. ClusterID is ignored.
+func (p *Plan) ToNodeDeploymentTemplate() (*models.NodeDeployment, error) {
+	p.SetDefaults()
+	if err := kkp.ValidateResourceName(p.Name); err != nil {
+		return nil, err
+	}
+	if err := p.validateNodeSpec(); err != nil {
+		return nil, err
+	}
+	nd, err := p.buildMachineDeploymentSpec()
+	if err != nil {
+		return nil, err
+	}
+	// KKP fills in the cluster labels when the template is instantiated.
+	nd.Spec.Template.Labels = nil
+	return nd, nil
+}
+
 func (p *Plan) buildMachineDeploymentSpec() (*models.NodeDeployment, error) {
 	spec := &models.NodeDeploymentSpec{
 		Replicas: &p.Replicas,
@@ -2,7 +2,6 @@ package machine_deployment_v2
 
 import (
 	"context"
-	"encoding/json"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -26,6 +25,7 @@ var (
 	_ resource.ResourceWithConfigure        = &resourceMachineDeployment{}
 	_ resource.ResourceWithImportState      = &resourceMachineDeployment{}
 	_ resource.ResourceWithConfigValidators = &resourceMachineDeployment{}
+	_ resource.ResourceWithModifyPlan       = &resourceMachineDeployment{}
 )
 
 // clusterIDRequiresReplaceModifier marks the resource for replacement only when
@@ -74,6 +74,7 @@ func (r *resourceMachineDeployment) Schema(_ context.Context, _ resource.SchemaR
 func (r *resourceMachineDeployment) buildSchemaAttributes() map[string]rschema.Attribute {
 	return map[string]rschema.Attribute{
 		"id":                r.buildIDAttribute(),
+		"project_id":        kkp.ProjectIDResourceAttribute(),
 		"cluster_id":        r.buildClusterIDAttribute(),
 		"name":              r.buildNameAttribute(),
 		"replicas":          r.buildReplicasAttribute(),
@@ -83,6 +84,7 @@ func (r *resourceMachineDeployment) buildSchemaAttributes() map[string]rschema.A
 		"min_replicas":      r.buildMinReplicasAttribute(),
 		"max_replicas":      r.buildMaxReplicasAttribute(),
 		"cloud":             r.buildCloudAttribute(),
+		"wait_for_ready":    r.buildWaitForReadyAttribute(),
 	}
 }
 
@@ -207,6 +209,15 @@ func (r *resourceMachineDeployment) buildCloudAttribute() rschema.StringAttribut
 	}
 }
 
+// buildWaitForReadyAttribute builds the wait_for_ready attribute.
+func (r *resourceMachineDeployment) buildWaitForReadyAttribute() rschema.BoolAttribute {
+	return rschema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Wait for worker nodes to become available during creation. Defaults to true.",
+	}
+}
+
 // buildOpenstackBlock builds the openstack configuration block.
 func (r *resourceMachineDeployment) buildOpenstackBlock() rschema.SingleNestedBlock {
 	return rschema.SingleNestedBlock{
@@ -266,6 +277,10 @@ func (r *resourceMachineDeployment) Configure(_ context.Context, req resource.Co
 	r.ConfigureResource(req, resp)
 }
 
+func (r *resourceMachineDeployment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
 func (r *resourceMachineDeployment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.ValidateResourceBase(resp) {
 		return
@@ -283,12 +298,15 @@ func (r *resourceMachineDeployment) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Create machine deployment
-	machineDeploymentID, err := r.createMachineDeployment(ctx, cp, resp)
+	plan.ProjectID = tftypes.StringValue(r.ProjectID(plan.ProjectID))
+	waitForReady := kkp.BoolValueOrDefault(plan.WaitForReady, true)
+	machineDeploymentID, err := r.createMachineDeployment(ctx, plan.ProjectID.ValueString(), cp, waitForReady, resp)
 	if err != nil {
 		return
 	}
 
 	// Build and persist final state
+	plan.WaitForReady = tftypes.BoolValue(waitForReady)
 	r.buildAndPersistCreateState(ctx, *plan, machineDeploymentID, resp)
 }
 
@@ -313,24 +331,26 @@ func (r *resourceMachineDeployment) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	get := kapi.NewGetMachineDeploymentParams().
-		WithProjectID(r.DefaultProjectID).
+	projectID := r.ProjectID(state.ProjectID)
+	pcli := r.Client.Project
+	get := kapi.NewGetMachineDeploymentParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithMachineDeploymentID(id)
 
 	got, err := pcli.GetMachineDeployment(get, nil)
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "not found") || strings.Contains(err.Error(), "404") {
+		if kkp.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Read machine deployment failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Read machine deployment failed", err)
 		return
 	}
 
 	// Update state from API response
 	state.ID = tftypes.StringValue(got.Payload.ID)
+	state.ProjectID = tftypes.StringValue(projectID)
 	state.Name = tftypes.StringValue(got.Payload.Name)
 	if got.Payload.Spec != nil {
 		if got.Payload.Spec.Replicas != nil {
@@ -342,6 +362,7 @@ func (r *resourceMachineDeployment) Read(ctx context.Context, req resource.ReadR
 		state.Paused = tftypes.BoolValue(got.Payload.Spec.Paused)
 	}
 	// k8s_version, paused, and min_ready_seconds are preserved from existing state as they may not be reliably returned by the API
+	state.WaitForReady = tftypes.BoolValue(kkp.BoolValueOrDefault(state.WaitForReady, true))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -368,6 +389,7 @@ func (r *resourceMachineDeployment) Update(ctx context.Context, req resource.Upd
 		resp.Diagnostics.AddError("Missing identifiers", "State missing machine deployment or cluster ID.")
 		return
 	}
+	projectID := r.ProjectID(state.ProjectID)
 
 	// Detect changes that need to be applied
 	changes := r.detectUpdateChanges(*plan, state)
@@ -378,12 +400,12 @@ func (r *resourceMachineDeployment) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Apply the changes
-	if err := r.applyUpdateChanges(ctx, changes, clusterID, id, resp); err != nil {
+	if err := r.applyUpdateChanges(ctx, changes, projectID, clusterID, id, resp); err != nil {
 		return
 	}
 
 	// Build and persist final state
-	r.buildAndPersistUpdateState(ctx, *plan, state, clusterID, id, resp)
+	r.buildAndPersistUpdateState(ctx, *plan, state, projectID, clusterID, id, resp)
 }
 
 func (r *resourceMachineDeployment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -401,21 +423,22 @@ func (r *resourceMachineDeployment) Delete(ctx context.Context, req resource.Del
 	if id == "" || clusterID == "" {
 		return
 	}
+	projectID := r.ProjectID(state.ProjectID)
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	del := kapi.NewDeleteMachineDeploymentParams().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	del := kapi.NewDeleteMachineDeploymentParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithMachineDeploymentID(id)
 
-	if _, err := pcli.DeleteMachineDeployment(del, nil); err != nil {
-		resp.Diagnostics.AddWarning("Delete machine deployment warning", err.Error())
+	if _, err := pcli.DeleteMachineDeployment(del, nil); err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIWarning(&resp.Diagnostics, "Delete machine deployment warning", err)
 	}
 
 	// Wait for machine deployment to be deleted
 	checker := &kkp.MachineDeploymentHealthChecker{
 		Client:              r.Client,
-		ProjectID:           r.DefaultProjectID,
+		ProjectID:           projectID,
 		ClusterID:           clusterID,
 		MachineDeploymentID: id,
 	}
@@ -429,10 +452,14 @@ func (r *resourceMachineDeployment) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *resourceMachineDeployment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: cluster_id:machine_deployment_id
-	parts := strings.Split(req.ID, ":")
+	// Import format: [project_id:]cluster_id:machine_deployment_id
+	id := kkp.ImportProjectID(ctx, req, resp, 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parts := strings.Split(id, ":")
 	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Unexpected import ID", "Expected 'cluster_id:machine_deployment_id'")
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected 'cluster_id:machine_deployment_id' or 'project_id:cluster_id:machine_deployment_id'")
 		return
 	}
 
@@ -601,19 +628,19 @@ func (r *resourceMachineDeployment) setOpenstackConfig(plan machineDeploymentSta
 	return nil
 }
 
-// createMachineDeployment creates the machine deployment via API and waits for it to be ready.
-func (r *resourceMachineDeployment) createMachineDeployment(ctx context.Context, cp *Plan, resp *resource.CreateResponse) (string, error) {
+// createMachineDeployment creates the machine deployment via API and, when waitForReady is set, waits for it to be ready.
+func (r *resourceMachineDeployment) createMachineDeployment(ctx context.Context, projectID string, cp *Plan, waitForReady bool, resp *resource.CreateResponse) (string, error) {
 	spec, err := cp.ToMachineDeploymentSpec()
 	if err != nil {
 		resp.Diagnostics.AddError("Machine deployment spec invalid", err.Error())
 		return "", err
 	}
 
-	pcli := kapi.New(r.Client.Transport, nil)
+	pcli := r.Client.Project
 
 	clusterChecker := &kkp.ClusterHealthChecker{
 		Client:    r.Client,
-		ProjectID: r.DefaultProjectID,
+		ProjectID: projectID,
 		ClusterID: cp.ClusterID,
 	}
 	if readyErr := clusterChecker.WaitForClusterReady(ctx); readyErr != nil {
@@ -621,28 +648,31 @@ func (r *resourceMachineDeployment) createMachineDeployment(ctx context.Context,
 		return "", readyErr
 	}
 
-	params := kapi.NewCreateMachineDeploymentParams().
-		WithProjectID(r.DefaultProjectID).
+	params := kapi.NewCreateMachineDeploymentParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(cp.ClusterID).
 		WithBody(spec)
 
 	out, err := pcli.CreateMachineDeployment(params, nil)
 	if err != nil {
-		if e, ok := err.(*kapi.CreateMachineDeploymentDefault); ok && e.Payload != nil {
-			b, _ := json.MarshalIndent(e.Payload, "", "  ")
-			resp.Diagnostics.AddError("Create machine deployment failed", string(b))
-			return "", err
-		}
-		resp.Diagnostics.AddError("Create machine deployment failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Create machine deployment failed", err)
 		return "", err
 	}
 
 	machineDeploymentID := out.Payload.ID
 
+	if !waitForReady {
+		tflog.Info(ctx, "skipping machine deployment readiness check (wait_for_ready=false)", map[string]any{
+			"cluster_id":            cp.ClusterID,
+			"machine_deployment_id": machineDeploymentID,
+		})
+		return machineDeploymentID, nil
+	}
+
 	// Wait for machine deployment to become ready
 	checker := &kkp.MachineDeploymentHealthChecker{
 		Client:              r.Client,
-		ProjectID:           r.DefaultProjectID,
+		ProjectID:           projectID,
 		ClusterID:           cp.ClusterID,
 		MachineDeploymentID: machineDeploymentID,
 	}
@@ -658,15 +688,15 @@ func (r *resourceMachineDeployment) createMachineDeployment(ctx context.Context,
 // buildAndPersistCreateState builds the final state after creation and persists it.
 func (r *resourceMachineDeployment) buildAndPersistCreateState(ctx context.Context, plan machineDeploymentState, machineDeploymentID string, resp *resource.CreateResponse) {
 	// Get the created machine deployment to build accurate state
-	pcli := kapi.New(r.Client.Transport, nil)
-	getParams := kapi.NewGetMachineDeploymentParams().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	getParams := kapi.NewGetMachineDeploymentParams().WithContext(ctx).
+		WithProjectID(plan.ProjectID.ValueString()).
 		WithClusterID(plan.ClusterID.ValueString()).
 		WithMachineDeploymentID(machineDeploymentID)
 
 	got, err := pcli.GetMachineDeployment(getParams, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Read machine deployment after creation failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Read machine deployment after creation failed", err)
 		return
 	}
 
@@ -754,11 +784,13 @@ func mergePlanWithExistingState(plan, state machineDeploymentState) machineDeplo
 
 	merged.ID = state.ID
 	merged.ClusterID = state.ClusterID
+	kkp.MergeString(&merged.ProjectID, state.ProjectID)
 
 	kkp.MergeInt64(&merged.Replicas, state.Replicas)
 	kkp.MergeString(&merged.K8sVersion, state.K8sVersion)
 	kkp.MergeInt64(&merged.MinReadySeconds, state.MinReadySeconds)
 	kkp.MergeBool(&merged.Paused, state.Paused)
+	kkp.MergeBool(&merged.WaitForReady, state.WaitForReady)
 
 	if merged.OpenStack != nil && state.OpenStack != nil {
 		kkp.MergeInt64(&merged.OpenStack.DiskSize, state.OpenStack.DiskSize)
@@ -772,7 +804,7 @@ func mergePlanWithExistingState(plan, state machineDeploymentState) machineDeplo
 }
 
 // applyUpdateChanges applies the detected changes by building a patch and executing it.
-func (r *resourceMachineDeployment) applyUpdateChanges(ctx context.Context, changes *updateChanges, clusterID, id string, resp *resource.UpdateResponse) error {
+func (r *resourceMachineDeployment) applyUpdateChanges(ctx context.Context, changes *updateChanges, projectID, clusterID, id string, resp *resource.UpdateResponse) error {
 	// Build patch specification
 	patchBody, err := r.buildUpdatePatch(changes, resp)
 	if err != nil {
@@ -780,12 +812,12 @@ func (r *resourceMachineDeployment) applyUpdateChanges(ctx context.Context, chan
 	}
 
 	// Execute the patch
-	if err := r.executePatch(patchBody, clusterID, id, resp); err != nil {
+	if err := r.executePatch(ctx, patchBody, projectID, clusterID, id, resp); err != nil {
 		return err
 	}
 
 	// Wait for update to complete
-	return r.waitForUpdateCompletion(ctx, changes, clusterID, id, resp)
+	return r.waitForUpdateCompletion(ctx, changes, projectID, clusterID, id, resp)
 }
 
 // buildUpdatePatch builds the patch body for the update operation.
@@ -849,25 +881,25 @@ func (r *resourceMachineDeployment) buildAutoscalingPatch(changes *updateChanges
 }
 
 // executePatch executes the patch operation against the API.
-func (r *resourceMachineDeployment) executePatch(patchBody map[string]any, clusterID, id string, resp *resource.UpdateResponse) error {
-	pcli := kapi.New(r.Client.Transport, nil)
+func (r *resourceMachineDeployment) executePatch(ctx context.Context, patchBody map[string]any, projectID, clusterID, id string, resp *resource.UpdateResponse) error {
+	pcli := r.Client.Project
 	_, err := pcli.PatchMachineDeployment(
-		kapi.NewPatchMachineDeploymentParams().
-			WithProjectID(r.DefaultProjectID).
+		kapi.NewPatchMachineDeploymentParams().WithContext(ctx).
+			WithProjectID(projectID).
 			WithClusterID(clusterID).
 			WithMachineDeploymentID(id).
 			WithPatch(patchBody),
 		nil,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Patch machine deployment failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Patch machine deployment failed", err)
 		return err
 	}
 	return nil
 }
 
 // waitForUpdateCompletion waits for the update operation to complete.
-func (r *resourceMachineDeployment) waitForUpdateCompletion(ctx context.Context, changes *updateChanges, clusterID, id string, resp *resource.UpdateResponse) error {
+func (r *resourceMachineDeployment) waitForUpdateCompletion(ctx context.Context, changes *updateChanges, projectID, clusterID, id string, resp *resource.UpdateResponse) error {
 	tflog.Info(ctx, "machine deployment patch sent", map[string]any{
 		"cluster_id":            clusterID,
 		"machine_deployment_id": id,
@@ -877,7 +909,7 @@ func (r *resourceMachineDeployment) waitForUpdateCompletion(ctx context.Context,
 
 	checker := &kkp.MachineDeploymentHealthChecker{
 		Client:              r.Client,
-		ProjectID:           r.DefaultProjectID,
+		ProjectID:           projectID,
 		ClusterID:           clusterID,
 		MachineDeploymentID: id,
 		ExpectedReplicas:    changes.wantReplicas, // Wait for the expected replica count
@@ -892,17 +924,17 @@ func (r *resourceMachineDeployment) waitForUpdateCompletion(ctx context.Context,
 }
 
 // buildAndPersistUpdateState builds the final state after update and persists it.
-func (r *resourceMachineDeployment) buildAndPersistUpdateState(ctx context.Context, plan, state machineDeploymentState, clusterID, id string, resp *resource.UpdateResponse) {
+func (r *resourceMachineDeployment) buildAndPersistUpdateState(ctx context.Context, plan, state machineDeploymentState, projectID, clusterID, id string, resp *resource.UpdateResponse) {
 	// Read updated machine deployment to get current values for computed fields
-	pcli := kapi.New(r.Client.Transport, nil)
-	getParams := kapi.NewGetMachineDeploymentParams().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	getParams := kapi.NewGetMachineDeploymentParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithMachineDeploymentID(id)
 
 	got, err := pcli.GetMachineDeployment(getParams, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Read machine deployment after update failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Read machine deployment after update failed", err)
 		return
 	}
 
@@ -910,6 +942,7 @@ func (r *resourceMachineDeployment) buildAndPersistUpdateState(ctx context.Conte
 	finalState := plan
 	finalState.ID = state.ID
 	finalState.ClusterID = state.ClusterID
+	finalState.ProjectID = tftypes.StringValue(projectID)
 
 	// Set computed fields from API response to ensure they have known values
 	if got.Payload != nil && got.Payload.Spec != nil {
@@ -922,6 +955,10 @@ func (r *resourceMachineDeployment) buildAndPersistUpdateState(ctx context.Conte
 		finalState.Paused = tftypes.BoolValue(got.Payload.Spec.Paused)
 	}
 
+	// wait_for_ready only affects creation; keep the previous value when not configured
+	kkp.MergeBool(&finalState.WaitForReady, state.WaitForReady)
+	finalState.WaitForReady = tftypes.BoolValue(kkp.BoolValueOrDefault(finalState.WaitForReady, true))
+
 	// Set default for min_ready_seconds if not provided by user (similar to Create method)
 	if finalState.MinReadySeconds.IsNull() || finalState.MinReadySeconds.IsUnknown() {
 		finalState.MinReadySeconds = tftypes.Int64Value(0)
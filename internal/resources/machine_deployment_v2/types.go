@@ -86,6 +86,7 @@ type blockAzure struct{}
 
 type machineDeploymentState struct {
 	ID              tftypes.String `tfsdk:"id"`
+	ProjectID       tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
 	ClusterID       tftypes.String `tfsdk:"cluster_id"`
 	Name            tftypes.String `tfsdk:"name"`
 	Replicas        tftypes.Int64  `tfsdk:"replicas"`
@@ -95,6 +96,7 @@ type machineDeploymentState struct {
 	MinReplicas     tftypes.Int64  `tfsdk:"min_replicas"`
 	MaxReplicas     tftypes.Int64  `tfsdk:"max_replicas"`
 	Cloud           tftypes.String `tfsdk:"cloud"`
+	WaitForReady    tftypes.Bool   `tfsdk:"wait_for_ready"`
 
 	OpenStack *blockOpenStack `tfsdk:"openstack"`
 	AWS       *blockAWS       `tfsdk:"aws"`
@@ -0,0 +1,674 @@
+// Package preset_v2 implements the Terraform resource for KKP presets.
+package preset_v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	presetapi "github.com/kubermatic/go-kubermatic/client/preset"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+var (
+	_ resource.Resource                     = &resourcePreset{}
+	_ resource.ResourceWithConfigure        = &resourcePreset{}
+	_ resource.ResourceWithImportState      = &resourcePreset{}
+	_ resource.ResourceWithConfigValidators = &resourcePreset{}
+)
+
+// KKP provider names of the supported credential blocks, in the order they are applied.
+const (
+	providerOpenStack = "openstack"
+	providerAWS       = "aws"
+	providerAzure     = "azure"
+	providerVSphere   = "vsphere"
+)
+
+// New creates a new preset v2 resource.
+func New() resource.Resource { return &resourcePreset{} }
+
+func (r *resourcePreset) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preset_v2"
+}
+
+func (r *resourcePreset) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "KKP preset holding cloud credentials that clusters reference by name. Requires a KKP administrator token. " +
+			"KKP never returns credentials, so they are kept from configuration and credential blocks are empty after import.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Preset identifier (same as name).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rschema.StringAttribute{
+				Required:    true,
+				Description: "Preset name, as referenced by the preset attribute of kkp_cluster_v2.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": rschema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the preset can be used (default true).",
+			},
+			"projects": rschema.ListAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Project IDs the preset is restricted to. Available to all projects when unset. Not returned by the KKP API, so changes made outside Terraform are not detected.",
+			},
+			"required_emails": rschema.ListAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Email domains (e.g. example.com) whose users may use the preset. Available to all users when unset. Not returned by the KKP API, so changes made outside Terraform are not detected.",
+			},
+		},
+		Blocks: map[string]rschema.Block{
+			"openstack": rschema.SingleNestedBlock{
+				Description: "OpenStack credentials. Use either username and password or an application credential.",
+				Attributes: map[string]rschema.Attribute{
+					"datacenter": datacenterAttribute(),
+					"username": rschema.StringAttribute{
+						Optional:    true,
+						Description: "OpenStack username.",
+					},
+					"password": rschema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "OpenStack password.",
+					},
+					"project": rschema.StringAttribute{
+						Optional:    true,
+						Description: "OpenStack project (tenant) name.",
+					},
+					"project_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "OpenStack project (tenant) ID.",
+					},
+					"domain": rschema.StringAttribute{
+						Required:    true,
+						Description: "OpenStack domain name (e.g. 'default').",
+					},
+					"application_credential_id": rschema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "OpenStack application credential ID.",
+					},
+					"application_credential_secret": rschema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "OpenStack application credential secret.",
+					},
+					"network": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Neutron network name or ID.",
+					},
+					"security_groups": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Security group name.",
+					},
+					"subnet_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "IPv4 subnet ID.",
+					},
+					"router_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Router ID.",
+					},
+					"floating_ip_pool": rschema.StringAttribute{
+						Optional:    true,
+						Description: "External network / Floating IP pool.",
+					},
+				},
+			},
+			"aws": rschema.SingleNestedBlock{
+				Description: "AWS credentials.",
+				Attributes: map[string]rschema.Attribute{
+					"datacenter": datacenterAttribute(),
+					"access_key_id": rschema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "AWS access key ID.",
+					},
+					"secret_access_key": rschema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "AWS secret access key.",
+					},
+					"assume_role_arn": rschema.StringAttribute{
+						Optional:    true,
+						Description: "ARN of a role to assume with the access key.",
+					},
+					"assume_role_external_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "External ID used when assuming the role.",
+					},
+					"vpc_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "VPC ID.",
+					},
+					"route_table_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Route table ID.",
+					},
+					"security_group_id": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Security group ID.",
+					},
+					"instance_profile_name": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Instance profile used by worker nodes.",
+					},
+					"control_plane_role_arn": rschema.StringAttribute{
+						Optional:    true,
+						Description: "ARN of the IAM role used by the control plane.",
+					},
+				},
+			},
+			"azure": rschema.SingleNestedBlock{
+				Description: "Azure service principal credentials.",
+				Attributes: map[string]rschema.Attribute{
+					"datacenter": datacenterAttribute(),
+					"tenant_id": rschema.StringAttribute{
+						Required:    true,
+						Description: "Azure tenant ID.",
+					},
+					"subscription_id": rschema.StringAttribute{
+						Required:    true,
+						Description: "Azure subscription ID.",
+					},
+					"client_id": rschema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "Service principal client ID.",
+					},
+					"client_secret": rschema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "Service principal client secret.",
+					},
+					"resource_group": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Resource group for cluster resources.",
+					},
+					"vnet_resource_group": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Resource group of the virtual network.",
+					},
+					"vnet": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Virtual network name.",
+					},
+					"subnet": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Subnet name.",
+					},
+					"route_table": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Route table name.",
+					},
+					"security_group": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Security group name.",
+					},
+					"load_balancer_sku": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Load balancer SKU (basic or standard).",
+						Validators: []validator.String{
+							stringvalidator.OneOf("basic", "standard"),
+						},
+					},
+				},
+			},
+			"vsphere": rschema.SingleNestedBlock{
+				Description: "vSphere credentials.",
+				Attributes: map[string]rschema.Attribute{
+					"datacenter": datacenterAttribute(),
+					"username": rschema.StringAttribute{
+						Required:    true,
+						Description: "vSphere username.",
+					},
+					"password": rschema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "vSphere password.",
+					},
+					"datastore": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Datastore for cluster VMs.",
+					},
+					"datastore_cluster": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Datastore cluster for cluster VMs (alternative to datastore).",
+					},
+					"resource_pool": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Resource pool for cluster VMs.",
+					},
+					"base_path": rschema.StringAttribute{
+						Optional:    true,
+						Description: "Base folder path for cluster VMs.",
+					},
+					"networks": rschema.ListAttribute{
+						Optional:    true,
+						ElementType: tftypes.StringType,
+						Description: "Networks for cluster VMs.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func datacenterAttribute() rschema.StringAttribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Description: "Restrict these credentials to one datacenter. Usable in all datacenters of the provider when unset.",
+	}
+}
+
+func (r *resourcePreset) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot(providerOpenStack),
+			path.MatchRoot(providerAWS),
+			path.MatchRoot(providerAzure),
+			path.MatchRoot(providerVSphere),
+		),
+	}
+}
+
+func (r *resourcePreset) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourcePreset) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[presetState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	name := strings.TrimSpace(plan.Name.ValueString())
+
+	// The first call creates the preset; the following ones add further providers to it.
+	for _, provider := range configuredProviders(plan) {
+		body, diags := buildPresetBody(ctx, plan, provider)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if _, err := r.Client.Presets.CreatePreset(
+			presetapi.NewCreatePresetParams().WithContext(ctx).WithProviderName(provider).WithBody(body),
+			nil,
+		); err != nil {
+			if kkp.IsConflict(err) {
+				resp.Diagnostics.AddError(
+					"Preset already exists",
+					fmt.Sprintf("Preset '%s' already has %s credentials. Import it with 'terraform import' to manage it.", name, provider),
+				)
+				return
+			}
+			kkp.AddAPIError(&resp.Diagnostics, fmt.Sprintf("Create preset (%s) failed", provider), err)
+			return
+		}
+		tflog.Info(ctx, "preset provider created", map[string]any{
+			"preset":   name,
+			"provider": provider,
+		})
+	}
+
+	// Presets start enabled; disabling is a separate call.
+	if !plan.Enabled.ValueBool() {
+		if err := r.setEnabled(ctx, name, false); err != nil {
+			kkp.AddAPIError(&resp.Diagnostics, "Disable preset failed", err)
+			return
+		}
+	}
+
+	state := *plan
+	state.ID = tftypes.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePreset) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[presetState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	name := kkp.TrimmedStringValue(state.ID)
+	if name == "" {
+		name = kkp.TrimmedStringValue(state.Name)
+	}
+	if name == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain preset name.")
+		return
+	}
+
+	preset, err := r.getPreset(ctx, name)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Read preset failed", err)
+		return
+	}
+	if preset == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = tftypes.StringValue(preset.Name)
+	state.Name = tftypes.StringValue(preset.Name)
+	state.Enabled = tftypes.BoolValue(preset.Enabled)
+
+	// Drop credential blocks whose provider was removed outside Terraform so the next plan restores them.
+	// Credentials, projects and required_emails are not returned by the KKP preset API and are
+	// kept from state, so changes to them made outside Terraform are not detected.
+	present := reportedProviders(preset)
+	if !present[providerOpenStack] {
+		state.OpenStack = nil
+	}
+	if !present[providerAWS] {
+		state.AWS = nil
+	}
+	if !present[providerAzure] {
+		state.Azure = nil
+	}
+	if !present[providerVSphere] {
+		state.VSphere = nil
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourcePreset) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[presetState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state presetState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := strings.TrimSpace(plan.Name.ValueString())
+
+	// Decide between create and update from the providers KKP reports rather than from state,
+	// which has no credential blocks after an import.
+	current, err := r.getPreset(ctx, name)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Read preset failed", err)
+		return
+	}
+	existing := reportedProviders(current)
+
+	// Write every configured provider first so a preset switching providers is never empty.
+	for _, provider := range configuredProviders(plan) {
+		body, diags := buildPresetBody(ctx, plan, provider)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if existing[provider] {
+			_, err = r.Client.Presets.UpdatePreset(
+				presetapi.NewUpdatePresetParams().WithContext(ctx).WithProviderName(provider).WithBody(body),
+				nil,
+			)
+		} else {
+			_, err = r.Client.Presets.CreatePreset(
+				presetapi.NewCreatePresetParams().WithContext(ctx).WithProviderName(provider).WithBody(body),
+				nil,
+			)
+		}
+		if err != nil {
+			kkp.AddAPIError(&resp.Diagnostics, fmt.Sprintf("Update preset (%s) failed", provider), err)
+			return
+		}
+		delete(existing, provider)
+	}
+
+	// Supported providers left in the map are no longer configured.
+	for _, provider := range []string{providerOpenStack, providerAWS, providerAzure, providerVSphere} {
+		if !existing[provider] {
+			continue
+		}
+		_, err := r.Client.Presets.DeletePresetProvider(
+			presetapi.NewDeletePresetProviderParams().WithContext(ctx).
+				WithPresetName(name).
+				WithProviderName(provider),
+			nil,
+		)
+		if err != nil && !kkp.IsNotFound(err) {
+			kkp.AddAPIError(&resp.Diagnostics, fmt.Sprintf("Remove %s from preset failed", provider), err)
+			return
+		}
+	}
+
+	if plan.Enabled.ValueBool() != state.Enabled.ValueBool() {
+		if err := r.setEnabled(ctx, name, plan.Enabled.ValueBool()); err != nil {
+			kkp.AddAPIError(&resp.Diagnostics, "Update preset status failed", err)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "preset updated", map[string]any{
+		"preset": name,
+	})
+
+	final := *plan
+	final.ID = tftypes.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourcePreset) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[presetState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	name := kkp.TrimmedStringValue(state.ID)
+	if name == "" {
+		return
+	}
+
+	_, err := r.Client.Presets.DeletePreset(presetapi.NewDeletePresetParams().WithContext(ctx).WithPresetName(name), nil)
+	if err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIError(&resp.Diagnostics, "Delete preset failed", err)
+	}
+}
+
+func (r *resourcePreset) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name := strings.TrimSpace(req.ID)
+	if name == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<preset_name>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// getPreset returns the preset with the given name, including disabled ones, or nil when it
+// does not exist.
+func (r *resourcePreset) getPreset(ctx context.Context, name string) (*models.Preset, error) {
+	includeDisabled := true
+	out, err := r.Client.Presets.ListPresets(
+		presetapi.NewListPresetsParams().WithContext(ctx).
+			WithName(&name).
+			WithDisabled(&includeDisabled),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil || out.Payload == nil {
+		return nil, nil
+	}
+	for _, p := range out.Payload.Items {
+		if p != nil && p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// setEnabled enables or disables the whole preset.
+func (r *resourcePreset) setEnabled(ctx context.Context, name string, enabled bool) error {
+	_, err := r.Client.Presets.UpdatePresetStatus(
+		presetapi.NewUpdatePresetStatusParams().WithContext(ctx).
+			WithPresetName(name).
+			WithBody(presetapi.UpdatePresetStatusBody{Enabled: enabled}),
+		nil,
+	)
+	return err
+}
+
+// reportedProviders returns the names of the providers KKP reports for a preset.
+func reportedProviders(p *models.Preset) map[string]bool {
+	providers := map[string]bool{}
+	if p == nil {
+		return providers
+	}
+	for _, provider := range p.Providers {
+		if provider != nil {
+			providers[string(provider.Name)] = true
+		}
+	}
+	return providers
+}
+
+// configuredProviders returns the KKP provider names of the credential blocks set in s.
+func configuredProviders(s *presetState) []string {
+	var providers []string
+	if s.OpenStack != nil {
+		providers = append(providers, providerOpenStack)
+	}
+	if s.AWS != nil {
+		providers = append(providers, providerAWS)
+	}
+	if s.Azure != nil {
+		providers = append(providers, providerAzure)
+	}
+	if s.VSphere != nil {
+		providers = append(providers, providerVSphere)
+	}
+	return providers
+}
+
+// buildPresetBody builds the request body carrying the credentials of one provider together
+// with the preset-wide settings.
+func buildPresetBody(ctx context.Context, s *presetState, provider string) (*models.PresetBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spec := &models.PresetSpec{Enabled: s.Enabled.ValueBool()}
+	spec.Projects, diags = stringList(ctx, s.Projects, diags)
+	spec.RequiredEmails, diags = stringList(ctx, s.RequiredEmails, diags)
+
+	switch provider {
+	case providerOpenStack:
+		o := s.OpenStack
+		spec.Openstack = &models.Openstack{
+			Datacenter:                  o.Datacenter.ValueString(),
+			Username:                    o.Username.ValueString(),
+			Password:                    o.Password.ValueString(),
+			Project:                     o.Project.ValueString(),
+			ProjectID:                   o.ProjectID.ValueString(),
+			Domain:                      o.Domain.ValueString(),
+			ApplicationCredentialID:     o.ApplicationCredentialID.ValueString(),
+			ApplicationCredentialSecret: o.ApplicationCredentialSecret.ValueString(),
+			Network:                     o.Network.ValueString(),
+			SecurityGroups:              o.SecurityGroups.ValueString(),
+			SubnetID:                    o.SubnetID.ValueString(),
+			RouterID:                    o.RouterID.ValueString(),
+			FloatingIPPool:              o.FloatingIPPool.ValueString(),
+		}
+	case providerAWS:
+		a := s.AWS
+		spec.Aws = &models.AWS{
+			Datacenter:           a.Datacenter.ValueString(),
+			AccessKeyID:          a.AccessKeyID.ValueString(),
+			SecretAccessKey:      a.SecretAccessKey.ValueString(),
+			AssumeRoleARN:        a.AssumeRoleARN.ValueString(),
+			AssumeRoleExternalID: a.AssumeRoleExternalID.ValueString(),
+			VPCID:                a.VPCID.ValueString(),
+			RouteTableID:         a.RouteTableID.ValueString(),
+			SecurityGroupID:      a.SecurityGroupID.ValueString(),
+			InstanceProfileName:  a.InstanceProfileName.ValueString(),
+			ControlPlaneRoleARN:  a.ControlPlaneRoleARN.ValueString(),
+		}
+	case providerAzure:
+		a := s.Azure
+		spec.Azure = &models.Azure{
+			Datacenter:        a.Datacenter.ValueString(),
+			TenantID:          a.TenantID.ValueString(),
+			SubscriptionID:    a.SubscriptionID.ValueString(),
+			ClientID:          a.ClientID.ValueString(),
+			ClientSecret:      a.ClientSecret.ValueString(),
+			ResourceGroup:     a.ResourceGroup.ValueString(),
+			VNetResourceGroup: a.VNetResourceGroup.ValueString(),
+			VNetName:          a.VNet.ValueString(),
+			SubnetName:        a.Subnet.ValueString(),
+			RouteTableName:    a.RouteTable.ValueString(),
+			SecurityGroup:     a.SecurityGroup.ValueString(),
+			LoadBalancerSKU:   models.LBSKU(a.LoadBalancerSKU.ValueString()),
+		}
+	case providerVSphere:
+		v := s.VSphere
+		spec.Vsphere = &models.VSphere{
+			Datacenter:       v.Datacenter.ValueString(),
+			Username:         v.Username.ValueString(),
+			Password:         v.Password.ValueString(),
+			Datastore:        v.Datastore.ValueString(),
+			DatastoreCluster: v.DatastoreCluster.ValueString(),
+			ResourcePool:     v.ResourcePool.ValueString(),
+			BasePath:         v.BasePath.ValueString(),
+		}
+		spec.Vsphere.Networks, diags = stringList(ctx, v.Networks, diags)
+	}
+
+	return &models.PresetBody{Name: strings.TrimSpace(s.Name.ValueString()), Spec: spec}, diags
+}
+
+// stringList converts an optional list attribute, appending any conversion errors to diags.
+func stringList(ctx context.Context, v tftypes.List, diags diag.Diagnostics) ([]string, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+	var out []string
+	diags.Append(v.ElementsAs(ctx, &out, false)...)
+	return out, diags
+}
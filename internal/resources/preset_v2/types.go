@@ -0,0 +1,84 @@
+package preset_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourcePreset struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type stateOpenStack struct {
+	Datacenter                  tftypes.String `tfsdk:"datacenter"`
+	Username                    tftypes.String `tfsdk:"username"`
+	Password                    tftypes.String `tfsdk:"password"`
+	Project                     tftypes.String `tfsdk:"project"`
+	ProjectID                   tftypes.String `tfsdk:"project_id"`
+	Domain                      tftypes.String `tfsdk:"domain"`
+	ApplicationCredentialID     tftypes.String `tfsdk:"application_credential_id"`
+	ApplicationCredentialSecret tftypes.String `tfsdk:"application_credential_secret"`
+	Network                     tftypes.String `tfsdk:"network"`
+	SecurityGroups              tftypes.String `tfsdk:"security_groups"`
+	SubnetID                    tftypes.String `tfsdk:"subnet_id"`
+	RouterID                    tftypes.String `tfsdk:"router_id"`
+	FloatingIPPool              tftypes.String `tfsdk:"floating_ip_pool"`
+}
+
+type stateAWS struct {
+	Datacenter           tftypes.String `tfsdk:"datacenter"`
+	AccessKeyID          tftypes.String `tfsdk:"access_key_id"`
+	SecretAccessKey      tftypes.String `tfsdk:"secret_access_key"`
+	AssumeRoleARN        tftypes.String `tfsdk:"assume_role_arn"`
+	AssumeRoleExternalID tftypes.String `tfsdk:"assume_role_external_id"`
+	VPCID                tftypes.String `tfsdk:"vpc_id"`
+	RouteTableID         tftypes.String `tfsdk:"route_table_id"`
+	SecurityGroupID      tftypes.String `tfsdk:"security_group_id"`
+	InstanceProfileName  tftypes.String `tfsdk:"instance_profile_name"`
+	ControlPlaneRoleARN  tftypes.String `tfsdk:"control_plane_role_arn"`
+}
+
+type stateAzure struct {
+	Datacenter        tftypes.String `tfsdk:"datacenter"`
+	TenantID          tftypes.String `tfsdk:"tenant_id"`
+	SubscriptionID    tftypes.String `tfsdk:"subscription_id"`
+	ClientID          tftypes.String `tfsdk:"client_id"`
+	ClientSecret      tftypes.String `tfsdk:"client_secret"`
+	ResourceGroup     tftypes.String `tfsdk:"resource_group"`
+	VNetResourceGroup tftypes.String `tfsdk:"vnet_resource_group"`
+	VNet              tftypes.String `tfsdk:"vnet"`
+	Subnet            tftypes.String `tfsdk:"subnet"`
+	RouteTable        tftypes.String `tfsdk:"route_table"`
+	SecurityGroup     tftypes.String `tfsdk:"security_group"`
+	LoadBalancerSKU   tftypes.String `tfsdk:"load_balancer_sku"`
+}
+
+type stateVSphere struct {
+	Datacenter       tftypes.String `tfsdk:"datacenter"`
+	Username         tftypes.String `tfsdk:"username"`
+	Password         tftypes.String `tfsdk:"password"`
+	Datastore        tftypes.String `tfsdk:"datastore"`
+	DatastoreCluster tftypes.String `tfsdk:"datastore_cluster"`
+	ResourcePool     tftypes.String `tfsdk:"resource_pool"`
+	BasePath         tftypes.String `tfsdk:"base_path"`
+	Networks         tftypes.List   `tfsdk:"networks"`
+}
+
+type presetState struct {
+	ID             tftypes.String `tfsdk:"id"`              // computed: preset name
+	Name           tftypes.String `tfsdk:"name"`            // required
+	Enabled        tftypes.Bool   `tfsdk:"enabled"`         // optional, default true
+	Projects       tftypes.List   `tfsdk:"projects"`        // optional: restrict to these project IDs
+	RequiredEmails tftypes.List   `tfsdk:"required_emails"` // optional: restrict to these email domains
+
+	// Credentials are write-only in the KKP API; Read keeps them from state.
+	OpenStack *stateOpenStack `tfsdk:"openstack"`
+	AWS       *stateAWS       `tfsdk:"aws"`
+	Azure     *stateAzure     `tfsdk:"azure"`
+	VSphere   *stateVSphere   `tfsdk:"vsphere"`
+}
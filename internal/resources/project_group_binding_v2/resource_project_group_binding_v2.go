@@ -0,0 +1,259 @@
+// Package project_group_binding_v2 implements the Terraform resource for KKP project group bindings.
+package project_group_binding_v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+var (
+	_ resource.Resource                = &resourceProjectGroupBinding{}
+	_ resource.ResourceWithConfigure   = &resourceProjectGroupBinding{}
+	_ resource.ResourceWithImportState = &resourceProjectGroupBinding{}
+	_ resource.ResourceWithModifyPlan  = &resourceProjectGroupBinding{}
+)
+
+// New creates a new project group binding v2 resource.
+func New() resource.Resource { return &resourceProjectGroupBinding{} }
+
+func (r *resourceProjectGroupBinding) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_group_binding_v2"
+}
+
+func (r *resourceProjectGroupBinding) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Grants the members of an OIDC group a role in a KKP project. Uses the provider-level project_id unless project_id is set.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Binding name assigned by KKP.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"group": rschema.StringAttribute{
+				Required:    true,
+				Description: "OIDC group name as it appears in the users' token.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role": rschema.StringAttribute{
+				Required:    true,
+				Description: "Project role granted to the group: owners, editors, viewers or projectmanagers.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(kkp.ProjectRoles...),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceProjectGroupBinding) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceProjectGroupBinding) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
+func (r *resourceProjectGroupBinding) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[projectGroupBindingState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	group := strings.TrimSpace(plan.Group.ValueString())
+	role := plan.Role.ValueString()
+
+	pcli := r.Client.Project
+	out, err := pcli.CreateGroupProjectBinding(
+		kapi.NewCreateGroupProjectBindingParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithBody(&models.GroupProjectBindingBody{Group: group, Role: role}),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsConflict(err) {
+			resp.Diagnostics.AddError(
+				"Group binding already exists",
+				fmt.Sprintf("Group '%s' is already bound in project '%s'. Import the binding with 'terraform import' to manage it.", group, projectID),
+			)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Create group project binding failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil || out.Payload.Name == "" {
+		resp.Diagnostics.AddError("Create group project binding failed", "KKP returned no binding name.")
+		return
+	}
+
+	tflog.Info(ctx, "group project binding created", map[string]any{
+		"project_id": projectID,
+		"binding":    out.Payload.Name,
+		"group":      group,
+		"role":       role,
+	})
+
+	state := *plan
+	applyBinding(&state, out.Payload, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectGroupBinding) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[projectGroupBindingState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	name := kkp.TrimmedStringValue(state.ID)
+	if name == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain binding name.")
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	pcli := r.Client.Project
+	out, err := pcli.GetGroupProjectBinding(
+		kapi.NewGetGroupProjectBindingParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithBindingName(name),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Read group project binding failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	applyBinding(state, out.Payload, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceProjectGroupBinding) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[projectGroupBindingState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state projectGroupBindingState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := kkp.TrimmedStringValue(state.ID)
+	if name == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain binding name.")
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	pcli := r.Client.Project
+	out, err := pcli.PatchGroupProjectBinding(
+		kapi.NewPatchGroupProjectBindingParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithBindingName(name).
+			WithBody(kapi.PatchGroupProjectBindingBody{
+				Group: strings.TrimSpace(plan.Group.ValueString()),
+				Role:  plan.Role.ValueString(),
+			}),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Update group project binding failed", err)
+		return
+	}
+
+	final := *plan
+	final.ID = state.ID
+	final.ProjectID = tftypes.StringValue(projectID)
+	if out != nil && out.Payload != nil {
+		applyBinding(&final, out.Payload, projectID)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourceProjectGroupBinding) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[projectGroupBindingState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	name := kkp.TrimmedStringValue(state.ID)
+	if name == "" {
+		return
+	}
+
+	pcli := r.Client.Project
+	_, err := pcli.DeleteGroupProjectBinding(
+		kapi.NewDeleteGroupProjectBindingParams().WithContext(ctx).
+			WithProjectID(r.ProjectID(state.ProjectID)).
+			WithBindingName(name),
+		nil,
+	)
+	if err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIError(&resp.Diagnostics, "Delete group project binding failed", err)
+	}
+}
+
+func (r *resourceProjectGroupBinding) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<binding_name>' or '<project_id>:<binding_name>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// applyBinding copies the API view of a group project binding into state.
+func applyBinding(state *projectGroupBindingState, b *models.GroupProjectBinding, projectID string) {
+	if b.Name != "" {
+		state.ID = tftypes.StringValue(b.Name)
+	}
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.Group = tftypes.StringValue(b.Group)
+	state.Role = tftypes.StringValue(b.Role)
+}
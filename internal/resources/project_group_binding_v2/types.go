@@ -0,0 +1,22 @@
+package project_group_binding_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceProjectGroupBinding struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type projectGroupBindingState struct {
+	ID        tftypes.String `tfsdk:"id"`         // computed: binding name
+	ProjectID tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
+	Group     tftypes.String `tfsdk:"group"`      // required: OIDC group
+	Role      tftypes.String `tfsdk:"role"`       // required: owners, editors, viewers, projectmanagers
+}
@@ -0,0 +1,308 @@
+// Package project_member_v2 implements the Terraform resource for KKP project members.
+package project_member_v2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	userapi "github.com/kubermatic/go-kubermatic/client/users"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+var (
+	_ resource.Resource                = &resourceProjectMember{}
+	_ resource.ResourceWithConfigure   = &resourceProjectMember{}
+	_ resource.ResourceWithImportState = &resourceProjectMember{}
+	_ resource.ResourceWithModifyPlan  = &resourceProjectMember{}
+)
+
+// New creates a new project member v2 resource.
+func New() resource.Resource { return &resourceProjectMember{} }
+
+func (r *resourceProjectMember) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_member_v2"
+}
+
+func (r *resourceProjectMember) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Membership of a user in a KKP project. Uses the provider-level project_id unless project_id is set.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "KKP user ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"email": rschema.StringAttribute{
+				Required:    true,
+				Description: "Email address of the user. Changing it forces a new membership.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^@\s]+@[^@\s]+$`), "must be an email address"),
+				},
+			},
+			"group": rschema.StringAttribute{
+				Required:    true,
+				Description: "Project group of the user: owners, editors, viewers or projectmanagers.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(kkp.ProjectRoles...),
+				},
+			},
+			"name": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Display name of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceProjectMember) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceProjectMember) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
+func (r *resourceProjectMember) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[projectMemberState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	email := strings.TrimSpace(plan.Email.ValueString())
+	group := plan.Group.ValueString()
+
+	out, err := r.Client.Users.AddUserToProject(
+		userapi.NewAddUserToProjectParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithBody(&models.User{
+				Email:    email,
+				Projects: []*models.ProjectGroup{{ID: projectID, GroupPrefix: group}},
+			}),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsConflict(err) {
+			resp.Diagnostics.AddError(
+				"User is already a project member",
+				fmt.Sprintf("'%s' is already a member of project '%s'. Import the membership with 'terraform import' to manage it.", email, projectID),
+			)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Add user to project failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil || out.Payload.ID == "" {
+		resp.Diagnostics.AddError("Add user to project failed", "KKP returned no user ID.")
+		return
+	}
+
+	tflog.Info(ctx, "project member added", map[string]any{
+		"project_id": projectID,
+		"user_id":    out.Payload.ID,
+		"group":      group,
+	})
+
+	state := *plan
+	applyMember(&state, out.Payload, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectMember) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[projectMemberState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	user, err := r.findMember(ctx, projectID, kkp.TrimmedStringValue(state.ID), kkp.TrimmedStringValue(state.Email))
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Read project members failed", err)
+		return
+	}
+	if user == nil || memberGroup(user, projectID) == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	applyMember(state, user, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceProjectMember) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[projectMemberState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state projectMemberState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	userID := kkp.TrimmedStringValue(state.ID)
+	if userID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain user id.")
+		return
+	}
+
+	// Only the group can change in place.
+	projectID := r.ProjectID(plan.ProjectID)
+	group := plan.Group.ValueString()
+	out, err := r.Client.Users.EditUserInProject(
+		userapi.NewEditUserInProjectParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithUserID(userID).
+			WithBody(&models.User{
+				ID:       userID,
+				Email:    strings.TrimSpace(plan.Email.ValueString()),
+				Name:     state.Name.ValueString(),
+				Projects: []*models.ProjectGroup{{ID: projectID, GroupPrefix: group}},
+			}),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Update project member failed", err)
+		return
+	}
+
+	tflog.Info(ctx, "project member updated", map[string]any{
+		"project_id": projectID,
+		"user_id":    userID,
+		"group":      group,
+	})
+
+	final := *plan
+	final.ID = state.ID
+	final.Name = state.Name
+	final.ProjectID = tftypes.StringValue(projectID)
+	if out != nil && out.Payload != nil {
+		applyMember(&final, out.Payload, projectID)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourceProjectMember) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[projectMemberState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	userID := kkp.TrimmedStringValue(state.ID)
+	if userID == "" {
+		return
+	}
+
+	_, err := r.Client.Users.DeleteUserFromProject(
+		userapi.NewDeleteUserFromProjectParams().WithContext(ctx).
+			WithProjectID(r.ProjectID(state.ProjectID)).
+			WithUserID(userID),
+		nil,
+	)
+	if err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIError(&resp.Diagnostics, "Remove user from project failed", err)
+	}
+}
+
+func (r *resourceProjectMember) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept "<user_id|email>" or "<project_id>:<user_id|email>".
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<user_id|email>' or '<project_id>:<user_id|email>'")
+		return
+	}
+	if strings.Contains(id, "@") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), id)...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// findMember looks up a project member by user ID, or by email when the ID is not known yet
+// (e.g. right after an import by email). It returns nil when the user is not a member.
+func (r *resourceProjectMember) findMember(ctx context.Context, projectID, userID, email string) (*models.User, error) {
+	out, err := r.Client.Users.GetUsersForProject(
+		userapi.NewGetUsersForProjectParams().WithContext(ctx).WithProjectID(projectID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range out.Payload {
+		if u == nil {
+			continue
+		}
+		if userID != "" && u.ID == userID {
+			return u, nil
+		}
+		if userID == "" && email != "" && strings.EqualFold(u.Email, email) {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+// memberGroup returns the user's group in the project, or "" when the user has none.
+func memberGroup(u *models.User, projectID string) string {
+	for _, p := range u.Projects {
+		if p != nil && p.ID == projectID {
+			return kkp.ProjectRole(p.GroupPrefix, projectID)
+		}
+	}
+	return ""
+}
+
+// applyMember copies the API view of a project member into state. A configured email that
+// differs only in case is kept to avoid a perpetual diff.
+func applyMember(state *projectMemberState, u *models.User, projectID string) {
+	state.ID = tftypes.StringValue(u.ID)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.Name = tftypes.StringValue(u.Name)
+	if !strings.EqualFold(state.Email.ValueString(), u.Email) {
+		state.Email = tftypes.StringValue(u.Email)
+	}
+	if g := memberGroup(u, projectID); g != "" {
+		state.Group = tftypes.StringValue(g)
+	}
+}
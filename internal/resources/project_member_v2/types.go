@@ -0,0 +1,23 @@
+package project_member_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceProjectMember struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type projectMemberState struct {
+	ID        tftypes.String `tfsdk:"id"`         // computed: user ID
+	ProjectID tftypes.String `tfsdk:"project_id"` // optional, defaults to the provider project
+	Email     tftypes.String `tfsdk:"email"`      // required
+	Group     tftypes.String `tfsdk:"group"`      // required: owners, editors, viewers, projectmanagers
+	Name      tftypes.String `tfsdk:"name"`       // computed
+}
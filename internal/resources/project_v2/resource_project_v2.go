@@ -0,0 +1,379 @@
+// Package project_v2 implements the Terraform resource for KKP projects.
+package project_v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	kapi "github.com/kubermatic/go-kubermatic/client/project"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+var (
+	_ resource.Resource                = &resourceProject{}
+	_ resource.ResourceWithConfigure   = &resourceProject{}
+	_ resource.ResourceWithImportState = &resourceProject{}
+)
+
+const (
+	projectStatusActive = "Active"
+
+	projectPollInterval  = 2 * time.Second
+	projectActiveTimeout = 2 * time.Minute
+	projectDeleteTimeout = 10 * time.Minute
+)
+
+// New creates a new project v2 resource.
+func New() resource.Resource { return &resourceProject{} }
+
+func (r *resourceProject) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_v2"
+}
+
+func (r *resourceProject) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "KKP project. Does not use the provider-level project_id.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Project ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rschema.StringAttribute{
+				Required:    true,
+				Description: "Project name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"labels": rschema.MapAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Project labels as key-value pairs.",
+			},
+			"owners": rschema.ListAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "Email addresses of the project owners.",
+			},
+			"status": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Project status (Active, Inactive or Terminating).",
+			},
+			"creation_time": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Project creation timestamp (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceProject) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceProject) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[projectState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	labels, diags := labelsFromPlan(ctx, plan.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pcli := r.Client.Project
+	out, err := pcli.CreateProject(
+		kapi.NewCreateProjectParams().WithContext(ctx).
+			WithBody(kapi.CreateProjectBody{
+				Name:   strings.TrimSpace(plan.Name.ValueString()),
+				Labels: labels,
+			}),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsForbidden(err) {
+			resp.Diagnostics.AddError(
+				"Create project failed - Forbidden",
+				fmt.Sprintf("Access denied when creating project '%s'. Project creation may be restricted to administrators on this KKP installation.\n\n%s", plan.Name.ValueString(), kkp.APIErrorDetail(err)),
+			)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Create project failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil || out.Payload.ID == "" {
+		resp.Diagnostics.AddError("Create project failed", "KKP returned no project ID.")
+		return
+	}
+	projectID := out.Payload.ID
+
+	tflog.Info(ctx, "project created", map[string]any{
+		"project_id": projectID,
+		"name":       out.Payload.Name,
+	})
+
+	// Persist the ID right away so a failed wait does not orphan the project.
+	state := *plan
+	state.ID = tftypes.StringValue(projectID)
+	resp.Diagnostics.Append(r.applyProject(ctx, &state, out.Payload)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.waitForProjectActive(ctx, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Project did not become active", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.applyProject(ctx, &state, project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProject) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[projectState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	projectID := kkp.TrimmedStringValue(state.ID)
+	if projectID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain project id.")
+		return
+	}
+
+	project, err := r.getProject(ctx, projectID)
+	if err != nil {
+		if kkp.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Read project failed", err)
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyProject(ctx, state, project)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceProject) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[projectState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state projectState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := kkp.TrimmedStringValue(state.ID)
+	if projectID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain project id.")
+		return
+	}
+
+	labels, diags := labelsFromPlan(ctx, plan.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The update endpoint replaces the whole project, so start from the current object.
+	current, err := r.getProject(ctx, projectID)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Read project before update failed", err)
+		return
+	}
+	current.Name = strings.TrimSpace(plan.Name.ValueString())
+	current.Labels = labels
+
+	pcli := r.Client.Project
+	out, err := pcli.UpdateProject(
+		kapi.NewUpdateProjectParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithBody(current),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Update project failed", err)
+		return
+	}
+
+	tflog.Info(ctx, "project updated", map[string]any{
+		"project_id": projectID,
+		"name":       current.Name,
+	})
+
+	updated := current
+	if out != nil && out.Payload != nil {
+		updated = out.Payload
+	}
+	final := *plan
+	final.ID = state.ID
+	resp.Diagnostics.Append(r.applyProject(ctx, &final, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourceProject) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[projectState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	projectID := kkp.TrimmedStringValue(state.ID)
+	if projectID == "" {
+		return
+	}
+
+	pcli := r.Client.Project
+	if _, err := pcli.DeleteProject(kapi.NewDeleteProjectParams().WithContext(ctx).WithProjectID(projectID), nil); err != nil {
+		if kkp.IsNotFound(err) {
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Delete project failed", err)
+		return
+	}
+
+	// KKP removes the project asynchronously, after everything in it has been deleted.
+	err := kkp.PollWithTimeout(ctx, projectPollInterval, projectDeleteTimeout, func(pc context.Context) (bool, error) {
+		_, gerr := r.getProject(pc, projectID)
+		if gerr == nil {
+			return false, nil
+		}
+		if kkp.IsNotFound(gerr) {
+			return true, nil
+		}
+		if kkp.IsTransient(gerr) {
+			return false, nil
+		}
+		return false, gerr
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Project deletion did not complete", err.Error())
+	}
+}
+
+func (r *resourceProject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := strings.TrimSpace(req.ID)
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<project_id>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// getProject fetches a single project.
+func (r *resourceProject) getProject(ctx context.Context, projectID string) (*models.Project, error) {
+	out, err := r.Client.Project.GetProject(kapi.NewGetProjectParams().WithContext(ctx).WithProjectID(projectID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil || out.Payload == nil {
+		return nil, fmt.Errorf("project %s: empty response", projectID)
+	}
+	return out.Payload, nil
+}
+
+// waitForProjectActive polls until the project reports the Active status and returns it.
+func (r *resourceProject) waitForProjectActive(ctx context.Context, projectID string) (*models.Project, error) {
+	var project *models.Project
+	err := kkp.PollWithTimeout(ctx, projectPollInterval, projectActiveTimeout, func(pc context.Context) (bool, error) {
+		p, err := r.getProject(pc, projectID)
+		if err != nil {
+			if kkp.IsNotFound(err) || kkp.IsTransient(err) {
+				return false, nil // not visible yet
+			}
+			return false, err
+		}
+		project = p
+		return p.Status == projectStatusActive, nil
+	})
+	if err != nil {
+		if project != nil {
+			return nil, fmt.Errorf("project %s is %q: %w", projectID, project.Status, err)
+		}
+		return nil, err
+	}
+	return project, nil
+}
+
+// applyProject copies the API view of a project into state. Labels stay null when they are
+// not configured and the project has none.
+func (r *resourceProject) applyProject(ctx context.Context, state *projectState, p *models.Project) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = tftypes.StringValue(p.ID)
+	state.Name = tftypes.StringValue(p.Name)
+	state.Status = tftypes.StringValue(p.Status)
+	if !p.CreationTimestamp.IsZero() {
+		state.CreationTime = tftypes.StringValue(p.CreationTimestamp.String())
+	} else if state.CreationTime.IsUnknown() {
+		state.CreationTime = tftypes.StringNull()
+	}
+
+	if len(p.Labels) == 0 && state.Labels.IsNull() {
+		state.Labels = tftypes.MapNull(tftypes.StringType)
+	} else {
+		state.Labels = kkp.ConvertLabelsToTerraform(p.Labels)
+	}
+
+	owners := make([]string, 0, len(p.Owners))
+	for _, o := range p.Owners {
+		if o != nil && o.Email != "" {
+			owners = append(owners, o.Email)
+		}
+	}
+	ownerList, d := tftypes.ListValueFrom(ctx, tftypes.StringType, owners)
+	diags.Append(d...)
+	state.Owners = ownerList
+
+	return diags
+}
+
+// labelsFromPlan converts the labels attribute into the API representation.
+func labelsFromPlan(ctx context.Context, v tftypes.Map) (map[string]string, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	diags := v.ElementsAs(ctx, &labels, false)
+	return labels, diags
+}
@@ -0,0 +1,24 @@
+package project_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceProject struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type projectState struct {
+	ID           tftypes.String `tfsdk:"id"`            // computed
+	Name         tftypes.String `tfsdk:"name"`          // required
+	Labels       tftypes.Map    `tfsdk:"labels"`        // optional
+	Owners       tftypes.List   `tfsdk:"owners"`        // computed: owner emails
+	Status       tftypes.String `tfsdk:"status"`        // computed: Active, Inactive, Terminating
+	CreationTime tftypes.String `tfsdk:"creation_time"` // computed
+}
@@ -0,0 +1,336 @@
+// Package service_account_token_v2 implements the Terraform resource for KKP service account tokens.
+package service_account_token_v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	tokenapi "github.com/kubermatic/go-kubermatic/client/tokens"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+var (
+	_ resource.Resource                = &resourceServiceAccountToken{}
+	_ resource.ResourceWithConfigure   = &resourceServiceAccountToken{}
+	_ resource.ResourceWithImportState = &resourceServiceAccountToken{}
+	_ resource.ResourceWithModifyPlan  = &resourceServiceAccountToken{}
+)
+
+// New creates a new service account token v2 resource.
+func New() resource.Resource { return &resourceServiceAccountToken{} }
+
+func (r *resourceServiceAccountToken) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_token_v2"
+}
+
+func (r *resourceServiceAccountToken) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "API token of a KKP project service account. The token value is only returned when it is created or regenerated, so it is unknown after import. Uses the provider-level project_id unless project_id is set.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Token ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"service_account_id": rschema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service account the token belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": rschema.StringAttribute{
+				Required:    true,
+				Description: "Token name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"rotation_triggers": rschema.MapAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Arbitrary key-value pairs; any change regenerates the token in place (e.g. set a key to a time_rotating ID to rotate on a schedule).",
+			},
+			"token": rschema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token for the KKP API.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiry": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Token expiry timestamp (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_time": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Token creation timestamp (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceServiceAccountToken) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceServiceAccountToken) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior tftypes.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotation_triggers"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotation_triggers"), &prior)...)
+	if resp.Diagnostics.HasError() || planned.Equal(prior) {
+		return
+	}
+
+	// Rotation regenerates the token, so its value and expiry are not known until apply.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), tftypes.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expiry"), tftypes.StringUnknown())...)
+}
+
+func (r *resourceServiceAccountToken) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[serviceAccountTokenState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	saID := strings.TrimSpace(plan.ServiceAccountID.ValueString())
+	name := strings.TrimSpace(plan.Name.ValueString())
+	out, err := r.Client.Tokens.AddTokenToServiceAccount(
+		tokenapi.NewAddTokenToServiceAccountParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithServiceAccountID(saID).
+			WithBody(&models.ServiceAccountToken{Name: name}),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsConflict(err) {
+			resp.Diagnostics.AddError(
+				"Token name already exists",
+				fmt.Sprintf("Service account '%s' already has a token named '%s'. Choose a different name.", saID, name),
+			)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Create service account token failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil || out.Payload.ID == "" {
+		resp.Diagnostics.AddError("Create service account token failed", "KKP returned no token ID.")
+		return
+	}
+
+	tflog.Info(ctx, "service account token created", map[string]any{
+		"project_id":         projectID,
+		"service_account_id": saID,
+		"token_id":           out.Payload.ID,
+	})
+
+	state := *plan
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.ServiceAccountID = tftypes.StringValue(saID)
+	applyToken(&state, out.Payload)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceServiceAccountToken) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[serviceAccountTokenState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	tokenID := kkp.TrimmedStringValue(state.ID)
+	saID := kkp.TrimmedStringValue(state.ServiceAccountID)
+	if tokenID == "" || saID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain token and service account ids.")
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	out, err := r.Client.Tokens.ListServiceAccountTokens(
+		tokenapi.NewListServiceAccountTokensParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithServiceAccountID(saID),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsNotFound(err) {
+			resp.State.RemoveResource(ctx) // service account is gone
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Read service account tokens failed", err)
+		return
+	}
+
+	var found *models.PublicServiceAccountToken
+	for _, t := range out.Payload {
+		if t != nil && t.ID == tokenID {
+			found = t
+			break
+		}
+	}
+	if found == nil || found.Invalidated {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The token value is never returned on reads; keep the one from state.
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.Name = tftypes.StringValue(found.Name)
+	if state.Token.IsUnknown() {
+		state.Token = tftypes.StringNull()
+	}
+	if !found.Expiry.IsZero() {
+		state.Expiry = tftypes.StringValue(found.Expiry.String())
+	}
+	if !found.CreationTimestamp.IsZero() {
+		state.CreationTime = tftypes.StringValue(found.CreationTimestamp.String())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceServiceAccountToken) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[serviceAccountTokenState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state serviceAccountTokenState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	final := state
+	final.ProjectID = tftypes.StringValue(r.ProjectID(plan.ProjectID))
+	final.RotationTriggers = plan.RotationTriggers
+	if plan.RotationTriggers.Equal(state.RotationTriggers) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+		return
+	}
+
+	tokenID := kkp.TrimmedStringValue(state.ID)
+	saID := kkp.TrimmedStringValue(state.ServiceAccountID)
+	out, err := r.Client.Tokens.UpdateServiceAccountToken(
+		tokenapi.NewUpdateServiceAccountTokenParams().WithContext(ctx).
+			WithProjectID(final.ProjectID.ValueString()).
+			WithServiceAccountID(saID).
+			WithTokenID(tokenID).
+			WithBody(&models.PublicServiceAccountToken{ID: tokenID, Name: state.Name.ValueString()}),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Rotate service account token failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil {
+		resp.Diagnostics.AddError("Rotate service account token failed", "KKP returned no token.")
+		return
+	}
+
+	tflog.Info(ctx, "service account token rotated", map[string]any{
+		"service_account_id": saID,
+		"token_id":           tokenID,
+	})
+
+	applyToken(&final, out.Payload)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourceServiceAccountToken) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[serviceAccountTokenState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	tokenID := kkp.TrimmedStringValue(state.ID)
+	if tokenID == "" {
+		return
+	}
+
+	_, err := r.Client.Tokens.DeleteServiceAccountToken(
+		tokenapi.NewDeleteServiceAccountTokenParams().WithContext(ctx).
+			WithProjectID(r.ProjectID(state.ProjectID)).
+			WithServiceAccountID(state.ServiceAccountID.ValueString()).
+			WithTokenID(tokenID),
+		nil,
+	)
+	if err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIError(&resp.Diagnostics, "Delete service account token failed", err)
+	}
+}
+
+func (r *resourceServiceAccountToken) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := kkp.ImportProjectID(ctx, req, resp, 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	saID, tokenID, ok := strings.Cut(id, ":")
+	if !ok || saID == "" || tokenID == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<service_account_id>:<token_id>' or '<project_id>:<service_account_id>:<token_id>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_account_id"), saID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tokenID)...)
+}
+
+// applyToken copies a freshly created or regenerated token into state.
+func applyToken(state *serviceAccountTokenState, t *models.ServiceAccountToken) {
+	state.ID = tftypes.StringValue(t.ID)
+	state.Name = tftypes.StringValue(t.Name)
+	state.Token = tftypes.StringValue(t.Token)
+	if !t.Expiry.IsZero() {
+		state.Expiry = tftypes.StringValue(t.Expiry.String())
+	} else {
+		state.Expiry = tftypes.StringNull()
+	}
+	if !t.CreationTimestamp.IsZero() {
+		state.CreationTime = tftypes.StringValue(t.CreationTimestamp.String())
+	} else if state.CreationTime.IsUnknown() {
+		state.CreationTime = tftypes.StringNull()
+	}
+}
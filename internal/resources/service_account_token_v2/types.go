@@ -0,0 +1,26 @@
+package service_account_token_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceServiceAccountToken struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type serviceAccountTokenState struct {
+	ID               tftypes.String `tfsdk:"id"`                 // computed
+	ProjectID        tftypes.String `tfsdk:"project_id"`         // optional, defaults to the provider project
+	ServiceAccountID tftypes.String `tfsdk:"service_account_id"` // required
+	Name             tftypes.String `tfsdk:"name"`               // required
+	RotationTriggers tftypes.Map    `tfsdk:"rotation_triggers"`  // optional: any change regenerates the token
+	Token            tftypes.String `tfsdk:"token"`              // computed, sensitive
+	Expiry           tftypes.String `tfsdk:"expiry"`             // computed
+	CreationTime     tftypes.String `tfsdk:"creation_time"`      // computed
+}
@@ -0,0 +1,277 @@
+// Package service_account_v2 implements the Terraform resource for KKP project service accounts.
+package service_account_v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+
+	saapi "github.com/kubermatic/go-kubermatic/client/serviceaccounts"
+	"github.com/kubermatic/go-kubermatic/models"
+)
+
+var (
+	_ resource.Resource                = &resourceServiceAccount{}
+	_ resource.ResourceWithConfigure   = &resourceServiceAccount{}
+	_ resource.ResourceWithImportState = &resourceServiceAccount{}
+	_ resource.ResourceWithModifyPlan  = &resourceServiceAccount{}
+)
+
+// Service accounts cannot be project owners.
+var serviceAccountGroups = []string{"editors", "viewers", "projectmanagers"}
+
+// New creates a new service account v2 resource.
+func New() resource.Resource { return &resourceServiceAccount{} }
+
+func (r *resourceServiceAccount) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_v2"
+}
+
+func (r *resourceServiceAccount) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Description: "Project service account in KKP. Mint API tokens for it with kkp_service_account_token_v2. Uses the provider-level project_id unless project_id is set.",
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Service account ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
+			"name": rschema.StringAttribute{
+				Required:    true,
+				Description: "Service account name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"group": rschema.StringAttribute{
+				Required:    true,
+				Description: "Project group of the service account: editors, viewers or projectmanagers. Managing SSH keys and clusters requires editors.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(serviceAccountGroups...),
+				},
+			},
+			"status": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Service account status.",
+			},
+			"creation_time": rschema.StringAttribute{
+				Computed:    true,
+				Description: "Service account creation timestamp (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceServiceAccount) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ConfigureResource(req, resp)
+}
+
+func (r *resourceServiceAccount) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+}
+
+func (r *resourceServiceAccount) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.ValidateResourceBase(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractPlan[serviceAccountState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	name := strings.TrimSpace(plan.Name.ValueString())
+	out, err := r.Client.ServiceAccounts.AddServiceAccountToProject(
+		saapi.NewAddServiceAccountToProjectParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithBody(&models.ServiceAccount{Name: name, Group: plan.Group.ValueString()}),
+		nil,
+	)
+	if err != nil {
+		if kkp.IsConflict(err) {
+			resp.Diagnostics.AddError(
+				"Service account name already exists",
+				fmt.Sprintf("A service account named '%s' already exists in project '%s'. Choose a different name or import the existing one.", name, projectID),
+			)
+			return
+		}
+		kkp.AddAPIError(&resp.Diagnostics, "Create service account failed", err)
+		return
+	}
+	if out == nil || out.Payload == nil || out.Payload.ID == "" {
+		resp.Diagnostics.AddError("Create service account failed", "KKP returned no service account ID.")
+		return
+	}
+
+	tflog.Info(ctx, "service account created", map[string]any{
+		"project_id":         projectID,
+		"service_account_id": out.Payload.ID,
+	})
+
+	state := *plan
+	applyServiceAccount(&state, out.Payload, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceServiceAccount) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.ValidateResourceBaseRead(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractState[serviceAccountState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	saID := kkp.TrimmedStringValue(state.ID)
+	if saID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain service account id.")
+		return
+	}
+
+	projectID := r.ProjectID(state.ProjectID)
+	out, err := r.Client.ServiceAccounts.ListServiceAccounts(
+		saapi.NewListServiceAccountsParams().WithContext(ctx).WithProjectID(projectID),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Read service accounts failed", err)
+		return
+	}
+
+	var found *models.ServiceAccount
+	for _, sa := range out.Payload {
+		if sa != nil && sa.ID == saID {
+			found = sa
+			break
+		}
+	}
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	applyServiceAccount(state, found, projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceServiceAccount) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.ValidateResourceBaseUpdate(resp) {
+		return
+	}
+
+	plan, ok := kkp.ExtractStateForUpdate[serviceAccountState](ctx, req, resp)
+	if !ok {
+		return
+	}
+
+	var state serviceAccountState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	saID := kkp.TrimmedStringValue(state.ID)
+	if saID == "" {
+		resp.Diagnostics.AddError("Missing id", "State did not contain service account id.")
+		return
+	}
+
+	projectID := r.ProjectID(plan.ProjectID)
+	out, err := r.Client.ServiceAccounts.UpdateServiceAccount(
+		saapi.NewUpdateServiceAccountParams().WithContext(ctx).
+			WithProjectID(projectID).
+			WithServiceAccountID(saID).
+			WithBody(&models.ServiceAccount{
+				ID:    saID,
+				Name:  strings.TrimSpace(plan.Name.ValueString()),
+				Group: plan.Group.ValueString(),
+			}),
+		nil,
+	)
+	if err != nil {
+		kkp.AddAPIError(&resp.Diagnostics, "Update service account failed", err)
+		return
+	}
+
+	final := *plan
+	final.ID = state.ID
+	final.ProjectID = tftypes.StringValue(projectID)
+	final.Status = state.Status
+	final.CreationTime = state.CreationTime
+	if out != nil && out.Payload != nil {
+		applyServiceAccount(&final, out.Payload, projectID)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &final)...)
+}
+
+func (r *resourceServiceAccount) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.ValidateResourceBaseDelete(resp) {
+		return
+	}
+
+	state, ok := kkp.ExtractStateForDelete[serviceAccountState](ctx, req, resp)
+	if !ok {
+		return
+	}
+	saID := kkp.TrimmedStringValue(state.ID)
+	if saID == "" {
+		return
+	}
+
+	// Deleting the service account also revokes all of its tokens.
+	_, err := r.Client.ServiceAccounts.DeleteServiceAccount(
+		saapi.NewDeleteServiceAccountParams().WithContext(ctx).
+			WithProjectID(r.ProjectID(state.ProjectID)).
+			WithServiceAccountID(saID),
+		nil,
+	)
+	if err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIError(&resp.Diagnostics, "Delete service account failed", err)
+	}
+}
+
+func (r *resourceServiceAccount) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<service_account_id>' or '<project_id>:<service_account_id>'")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// applyServiceAccount copies the API view of a service account into state.
+func applyServiceAccount(state *serviceAccountState, sa *models.ServiceAccount, projectID string) {
+	state.ID = tftypes.StringValue(sa.ID)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.Name = tftypes.StringValue(sa.Name)
+	if sa.Group != "" {
+		state.Group = tftypes.StringValue(kkp.ProjectRole(sa.Group, projectID))
+	}
+	state.Status = tftypes.StringValue(sa.Status)
+	if !sa.CreationTimestamp.IsZero() {
+		state.CreationTime = tftypes.StringValue(sa.CreationTimestamp.String())
+	} else if state.CreationTime.IsUnknown() {
+		state.CreationTime = tftypes.StringNull()
+	}
+}
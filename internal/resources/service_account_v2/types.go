@@ -0,0 +1,24 @@
+package service_account_v2
+
+import (
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+// ---------- Resource-specific types ----------
+
+type resourceServiceAccount struct {
+	kkp.ResourceBase
+}
+
+// ---------- Local state structs to read plan/state ----------
+
+type serviceAccountState struct {
+	ID           tftypes.String `tfsdk:"id"`            // computed
+	ProjectID    tftypes.String `tfsdk:"project_id"`    // optional, defaults to the provider project
+	Name         tftypes.String `tfsdk:"name"`          // required
+	Group        tftypes.String `tfsdk:"group"`         // required: editors, viewers, projectmanagers
+	Status       tftypes.String `tfsdk:"status"`        // computed
+	CreationTime tftypes.String `tfsdk:"creation_time"` // computed
+}
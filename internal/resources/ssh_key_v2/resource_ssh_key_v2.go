@@ -4,10 +4,8 @@ package ssh_key_v2
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,25 +24,46 @@ var (
 	_ resource.Resource                = &resourceSSHKey{}
 	_ resource.ResourceWithConfigure   = &resourceSSHKey{}
 	_ resource.ResourceWithImportState = &resourceSSHKey{}
+	_ resource.ResourceWithModifyPlan  = &resourceSSHKey{}
 )
 
 // New creates a new SSH key v2 resource.
 func New() resource.Resource { return &resourceSSHKey{} }
 
-// fromAPIProjectSSHKey maps API SSHKey -> TF state (without project_id).
+// fromAPIProjectSSHKey maps API SSHKey -> TF state (without project_id; callers set it).
 // Note: KKP usually doesn't echo the public key on reads; caller keeps it in state.
 func fromAPIProjectSSHKey(p *models.SSHKey) projectSSHKeyState {
 	if p == nil {
 		return projectSSHKeyState{
-			ID:        tftypes.StringNull(),
-			Name:      tftypes.StringNull(),
-			PublicKey: tftypes.StringNull(),
+			ID:                tftypes.StringNull(),
+			Name:              tftypes.StringNull(),
+			PublicKey:         kkp.NewSSHPublicKeyNull(),
+			FingerprintMD5:    tftypes.StringNull(),
+			FingerprintSHA256: tftypes.StringNull(),
 		}
 	}
 	return projectSSHKeyState{
-		ID:        tftypes.StringValue(p.ID),
-		Name:      tftypes.StringValue(p.Name),
-		PublicKey: tftypes.StringNull(),
+		ID:                tftypes.StringValue(p.ID),
+		Name:              tftypes.StringValue(p.Name),
+		PublicKey:         kkp.NewSSHPublicKeyNull(),
+		FingerprintMD5:    tftypes.StringNull(),
+		FingerprintSHA256: tftypes.StringNull(),
+	}
+}
+
+// setFingerprints fills the fingerprint attributes from the parsed key, falling back to the
+// MD5 fingerprint KKP reports when the key is not known.
+func setFingerprints(state *projectSSHKeyState, key *kkp.SSHPublicKey, p *models.SSHKey) {
+	switch {
+	case key != nil:
+		state.FingerprintMD5 = tftypes.StringValue(key.FingerprintMD5())
+		state.FingerprintSHA256 = tftypes.StringValue(key.FingerprintSHA256())
+	case p != nil && p.Spec != nil && p.Spec.Fingerprint != "":
+		state.FingerprintMD5 = tftypes.StringValue(p.Spec.Fingerprint)
+		state.FingerprintSHA256 = tftypes.StringNull()
+	default:
+		state.FingerprintMD5 = tftypes.StringNull()
+		state.FingerprintSHA256 = tftypes.StringNull()
 	}
 }
 
@@ -54,32 +73,46 @@ func (r *resourceSSHKey) Metadata(_ context.Context, req resource.MetadataReques
 
 func (r *resourceSSHKey) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = rschema.Schema{
-		Description: "Project-scoped SSH key in Kubermatic Kubernetes Platform (KKP). Uses the provider-level project_id.",
+		Description: "Project-scoped SSH key in Kubermatic Kubernetes Platform (KKP). Uses the provider-level project_id unless project_id is set.",
 		Attributes: map[string]rschema.Attribute{
 			"id": rschema.StringAttribute{
 				Computed:    true,
 				Description: "SSH key ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"project_id": kkp.ProjectIDResourceAttribute(),
 			"name": rschema.StringAttribute{
 				Required:    true,
-				Description: "Human-friendly SSH key name.",
+				Description: "Human-friendly SSH key name. KKP cannot rename SSH keys, so changing it recreates the key.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"public_key": rschema.StringAttribute{
 				Required:    true,
-				Description: "OpenSSH public key (one line: '<type> <base64> [comment]').",
+				CustomType:  kkp.SSHPublicKeyType{},
+				Description: "OpenSSH public key (one line: '<type> <base64> [comment]'). Supported types: " + strings.Join(kkp.SupportedSSHKeyTypes, ", ") + ". Changing the key material recreates the key. Whitespace or comment changes only update Terraform state; KKP keeps the key as it was created.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						keyMaterialChanged,
+						"Changing the key material recreates the key.",
+						"Changing the key material recreates the key.",
+					),
 				},
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^\S+\s+[A-Za-z0-9+/=]+(?:\s+.+)?\s*$`),
-						"must be a valid OpenSSH public key",
-					),
+					publicKeyValidator{},
 				},
 			},
+			"fingerprint_md5": rschema.StringAttribute{
+				Computed:    true,
+				Description: "MD5 fingerprint of the key, as shown by KKP (e.g. 12:24:6f:...).",
+			},
+			"fingerprint_sha256": rschema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 fingerprint of the key, as shown by ssh-keygen -l (e.g. SHA256:fF8q...).",
+			},
 		},
 	}
 }
@@ -88,6 +121,26 @@ func (r *resourceSSHKey) Configure(_ context.Context, req resource.ConfigureRequ
 	r.ConfigureResource(req, resp)
 }
 
+func (r *resourceSSHKey) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.ModifyPlanProjectID(ctx, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fingerprints are derived from the key, so they are known at plan time.
+	var pub kkp.SSHPublicKeyValue
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("public_key"), &pub)...)
+	if resp.Diagnostics.HasError() || pub.IsUnknown() || pub.IsNull() {
+		return
+	}
+	key, err := kkp.ParseSSHPublicKey(pub.ValueString())
+	if err != nil {
+		return // reported by the validator
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint_md5"), key.FingerprintMD5())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fingerprint_sha256"), key.FingerprintSHA256())...)
+}
+
 func (r *resourceSSHKey) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.ValidateResourceBase(resp) {
 		return
@@ -98,23 +151,29 @@ func (r *resourceSSHKey) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	projectID := r.ProjectID(plan.ProjectID)
 	pub := plan.PublicKey.ValueString()
+	key, err := kkp.ParseSSHPublicKey(pub)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid SSH public key", err.Error())
+		return
+	}
 	body := &models.SSHKey{
 		Name: plan.Name.ValueString(),
 		Spec: &models.SSHKeySpec{
-			PublicKey: pub,
+			PublicKey: key.String(),
 		},
 	}
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	params := kapi.NewCreateSSHKeyParams().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	params := kapi.NewCreateSSHKeyParams().WithContext(ctx).
+		WithProjectID(projectID).
 		WithKey(body)
 
 	out, err := pcli.CreateSSHKey(params, nil)
 	if err != nil {
 		// Helpful hint for permission issues.
-		if strings.Contains(err.Error(), "createSshKeyForbidden") {
+		if kkp.IsForbidden(err) {
 			resp.Diagnostics.AddError(
 				"Permission denied (403)",
 				"The Service Account must be in the 'Editor' group to create SSH keys. "+
@@ -123,19 +182,21 @@ func (r *resourceSSHKey) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 		// Handle 409 Conflict - resource already exists with the same name.
-		if strings.Contains(err.Error(), "[409]") || strings.Contains(err.Error(), "createSSHKey default") {
+		if kkp.IsConflict(err) {
 			resp.Diagnostics.AddError(
 				"SSH key name already exists",
 				fmt.Sprintf("An SSH key with the name '%s' already exists in this project. Please choose a different name or remove the existing key first.", plan.Name.ValueString()),
 			)
 			return
 		}
-		resp.Diagnostics.AddError("Create project SSH key failed", err.Error())
+		kkp.AddAPIError(&resp.Diagnostics, "Create project SSH key failed", err)
 		return
 	}
 
 	state := fromAPIProjectSSHKey(out.Payload)
-	state.PublicKey = tftypes.StringValue(pub)
+	state.ProjectID = tftypes.StringValue(projectID)
+	state.PublicKey = plan.PublicKey
+	setFingerprints(&state, key, out.Payload)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -156,13 +217,12 @@ func (r *resourceSSHKey) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	listParams := kapi.NewListSSHKeysParams().WithProjectID(r.DefaultProjectID)
+	projectID := r.ProjectID(state.ProjectID)
+	pcli := r.Client.Project
+	listParams := kapi.NewListSSHKeysParams().WithContext(ctx).WithProjectID(projectID)
 	listOut, err := pcli.ListSSHKeys(listParams, nil)
 	if err != nil {
-		resp.Diagnostics.AddWarning("Read project SSH keys failed",
-			fmt.Sprintf("project %q: %v", r.DefaultProjectID, err))
-		resp.State.RemoveResource(ctx)
+		kkp.AddAPIError(&resp.Diagnostics, "Read project SSH keys failed", err)
 		return
 	}
 
@@ -179,11 +239,21 @@ func (r *resourceSSHKey) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	newState := fromAPIProjectSSHKey(found)
-	newState.PublicKey = state.PublicKey // preserve pubkey in state
+	newState.ProjectID = tftypes.StringValue(projectID)
+	newState.PublicKey = state.PublicKey
+	if found.Spec != nil && found.Spec.PublicKey != "" {
+		// Take the key KKP stored; semantic equality keeps the configured form in state
+		// when it holds the same key material.
+		newState.PublicKey = kkp.NewSSHPublicKeyValue(found.Spec.PublicKey)
+	}
+	key, _ := kkp.ParseSSHPublicKey(newState.PublicKey.ValueString())
+	setFingerprints(&newState, key, found)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
 func (r *resourceSSHKey) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// KKP has no SSH key update endpoint. Name and key material changes force replacement,
+	// so only whitespace or comment changes of public_key get here and are state-only.
 	plan, ok := kkp.ExtractStateForUpdate[projectSSHKeyState](ctx, req, resp)
 	if !ok {
 		return
@@ -206,27 +276,63 @@ func (r *resourceSSHKey) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	pcli := kapi.New(r.Client.Transport, nil)
-	del := kapi.NewDeleteSSHKeyParams().
-		WithProjectID(r.DefaultProjectID).
+	pcli := r.Client.Project
+	del := kapi.NewDeleteSSHKeyParams().WithContext(ctx).
+		WithProjectID(r.ProjectID(state.ProjectID)).
 		WithSSHKeyID(keyID)
 
-	if _, err := pcli.DeleteSSHKey(del, nil); err != nil {
-		resp.Diagnostics.AddWarning("Delete project SSH key warning",
-			fmt.Sprintf("project %q key %q: %v", r.DefaultProjectID, keyID, err))
+	if _, err := pcli.DeleteSSHKey(del, nil); err != nil && !kkp.IsNotFound(err) {
+		kkp.AddAPIWarning(&resp.Diagnostics, "Delete project SSH key warning", err)
 	}
 }
 
 func (r *resourceSSHKey) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Accept either "<key_id>" or "<project_id>/<key_id>"; we always keep only the key id in state.
-	id := strings.TrimSpace(req.ID)
-	if id == "" {
-		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<key_id>'")
+	// Accept "<key_id>", "<project_id>:<key_id>" or the older "<project_id>/<key_id>".
+	id := kkp.ImportProjectID(ctx, req, resp, 1)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	// If the user provides "<project>/<key>", take the last part.
-	if i := strings.LastIndex(id, "/"); i >= 0 {
-		id = id[i+1:]
+	if project, key, ok := strings.Cut(id, "/"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), project)...)
+		id = key
+	}
+	if id == "" {
+		resp.Diagnostics.AddError("Unexpected import ID", "Expected '<key_id>' or '<project_id>:<key_id>'")
+		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// --- public_key validation and normalization ---
+
+// publicKeyValidator checks that public_key is a well-formed OpenSSH key of a supported type.
+type publicKeyValidator struct{}
+
+func (publicKeyValidator) Description(context.Context) string {
+	return "must be a valid OpenSSH public key"
+}
+
+func (v publicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (publicKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := kkp.ParseSSHPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid SSH public key", err.Error())
+	}
+}
+
+// keyMaterialChanged requires replacement unless the old and new public_key hold the same
+// key, e.g. when file() picked up a trailing newline or only the comment changed.
+func keyMaterialChanged(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	prior, err := kkp.ParseSSHPublicKey(req.StateValue.ValueString())
+	if err != nil {
+		resp.RequiresReplace = true
+		return
+	}
+	planned, err := kkp.ParseSSHPublicKey(req.PlanValue.ValueString())
+	resp.RequiresReplace = err != nil || !prior.Equal(planned)
+}
@@ -0,0 +1,71 @@
+package ssh_key_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	tfgo "github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/armagankaratosun/terraform-provider-kkp/internal/kkp"
+)
+
+const testEd25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAID15obI36+rA24ItLwBCD71kmIBHqFhLK9xtB0wk8mQz me@host"
+
+func TestModifyPlanSetsFingerprints(t *testing.T) {
+	ctx := context.Background()
+	r := &resourceSSHKey{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	schema := schemaResp.Schema
+
+	planned := projectSSHKeyState{
+		ID:                tftypes.StringUnknown(),
+		ProjectID:         tftypes.StringValue("project"),
+		Name:              tftypes.StringValue("deploy"),
+		PublicKey:         kkp.NewSSHPublicKeyValue(testEd25519Key + "\n"),
+		FingerprintMD5:    tftypes.StringUnknown(),
+		FingerprintSHA256: tftypes.StringUnknown(),
+	}
+	config := planned
+	config.ID = tftypes.StringNull()
+	config.FingerprintMD5 = tftypes.StringNull()
+	config.FingerprintSHA256 = tftypes.StringNull()
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schema},
+		Plan:   tfsdk.Plan{Schema: schema},
+		State:  tfsdk.State{Schema: schema, Raw: tfgo.NewValue(schema.Type().TerraformType(ctx), nil)},
+	}
+	// Config has no Set method; build it from a plan with the same schema.
+	configPlan := tfsdk.Plan{Schema: schema}
+	if diags := configPlan.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("set config: %v", diags)
+	}
+	req.Config.Raw = configPlan.Raw
+	if diags := req.Plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatalf("set plan: %v", diags)
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan: %v", resp.Diagnostics)
+	}
+
+	for attr, want := range map[string]string{
+		"fingerprint_md5":    "12:24:6f:b3:d7:d7:df:8f:30:bb:91:d3:80:3c:67:3f",
+		"fingerprint_sha256": "SHA256:fF8qiuW+WWJgVZcM9Xk1xzB4txzeF6yFMIxsXkXexCE",
+	} {
+		var got tftypes.String
+		if diags := resp.Plan.GetAttribute(ctx, path.Root(attr), &got); diags.HasError() {
+			t.Fatalf("get %s: %v", attr, diags)
+		}
+		if got.ValueString() != want {
+			t.Errorf("%s = %q, want %q", attr, got.ValueString(), want)
+		}
+	}
+}
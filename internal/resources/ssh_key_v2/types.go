@@ -15,7 +15,11 @@ type resourceSSHKey struct {
 // ---------- Local state structs to read plan/state ----------
 
 type projectSSHKeyState struct {
-	ID        tftypes.String `tfsdk:"id"`         // computed
-	Name      tftypes.String `tfsdk:"name"`       // required
-	PublicKey tftypes.String `tfsdk:"public_key"` // required
+	ID        tftypes.String        `tfsdk:"id"`         // computed
+	ProjectID tftypes.String        `tfsdk:"project_id"` // optional, defaults to the provider project
+	Name      tftypes.String        `tfsdk:"name"`       // required
+	PublicKey kkp.SSHPublicKeyValue `tfsdk:"public_key"` // required
+
+	FingerprintMD5    tftypes.String `tfsdk:"fingerprint_md5"`    // computed
+	FingerprintSHA256 tftypes.String `tfsdk:"fingerprint_sha256"` // computed
 }